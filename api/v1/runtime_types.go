@@ -66,6 +66,11 @@ const (
 	ConditionTypeOidcConfigured         RuntimeConditionType = "OidcConfigured"
 	ConditionTypeRuntimeConfigured      RuntimeConditionType = "Configured"
 	ConditionTypeRuntimeDeprovisioned   RuntimeConditionType = "Deprovisioned"
+	ConditionTypeProvisioningTimeout    RuntimeConditionType = "ProvisioningTimeout"
+	ConditionTypeDriftDetected          RuntimeConditionType = "DriftDetected"
+	ConditionTypeShootAdopted           RuntimeConditionType = "ShootAdopted"
+	ConditionTypeRuntimeExpiring        RuntimeConditionType = "Expiring"
+	ConditionTypeRuntimeHibernated      RuntimeConditionType = "Hibernated"
 )
 
 type RuntimeConditionReason string
@@ -98,10 +103,35 @@ const (
 	ConditionReasonOidcError                = RuntimeConditionReason("OidcConfigurationErr")
 	ConditionReasonSeedNotFound             = RuntimeConditionReason("SeedNotFound")
 	ConditionReasonRegistryCacheError       = RuntimeConditionReason("RegistryCacheConfigurationErr")
+
+	ConditionReasonShootCreationTimeout = RuntimeConditionReason("ShootCreationTimeout")
+	ConditionReasonShootUpdateTimeout   = RuntimeConditionReason("ShootUpdateTimeout")
+	ConditionReasonShootDeletionTimeout = RuntimeConditionReason("ShootDeletionTimeout")
+
+	ConditionReasonShootDriftDetected = RuntimeConditionReason("ShootDriftDetected")
+	ConditionReasonShootInSync        = RuntimeConditionReason("ShootInSync")
+
+	ConditionReasonShootRetryRequested = RuntimeConditionReason("ShootRetryRequested")
+
+	ConditionReasonShootAdopted                   = RuntimeConditionReason("ShootAdopted")
+	ConditionReasonAdoptionPendingAcknowledgement = RuntimeConditionReason("AdoptionPendingAcknowledgement")
+	ConditionReasonShootToAdoptNotFound           = RuntimeConditionReason("ShootToAdoptNotFound")
+
+	ConditionReasonDeletionProtected = RuntimeConditionReason("DeletionProtected")
+
+	ConditionReasonShootSoftDeleted = RuntimeConditionReason("ShootSoftDeleted")
+	ConditionReasonRuntimeRestored  = RuntimeConditionReason("RuntimeRestored")
+
+	ConditionReasonRuntimeExpiring = RuntimeConditionReason("RuntimeExpiring")
+
+	ConditionReasonShootHibernated = RuntimeConditionReason("ShootHibernated")
+
+	ConditionReasonCredentialsRotationRequested = RuntimeConditionReason("CredentialsRotationRequested")
 )
 
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:storageversion
 //+kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.shoot.provider.type"
 //+kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.shoot.region"
 //+kubebuilder:printcolumn:name="STATE",type=string,JSONPath=`.status.state`
@@ -130,6 +160,8 @@ type RuntimeSpec struct {
 	Shoot    RuntimeShoot        `json:"shoot"`
 	Security Security            `json:"security"`
 	Caching  *ImageRegistryCache `json:"imageRegistryCache,omitempty"`
+	// ExpiresAt is the time after which the Runtime is deleted automatically
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
 type ImageRegistryCache struct {
@@ -148,6 +180,102 @@ type RuntimeStatus struct {
 
 	// ProvisioningCompleted indicates if the initial provisioning of the cluster is completed
 	ProvisioningCompleted bool `json:"provisioningCompleted,omitempty"`
+
+	// Shoot contains details of the Gardener Shoot observed during the last reconciliation
+	Shoot *ShootStatus `json:"shoot,omitempty"`
+
+	// TransitionHistory contains the outcomes of the most recent reconciliations of the Runtime Controller, the oldest first.
+	// The outcome repeating the last stored one is stored only together with other status changes.
+	TransitionHistory []StateTransition `json:"transitionHistory,omitempty"`
+
+	// LastDriftCheckTime is the time of the last comparison of the Shoot with the Runtime spec
+	LastDriftCheckTime *metav1.Time `json:"lastDriftCheckTime,omitempty"`
+
+	// RetryAttempts is the number of automatic retries of the failed shoot operation, it is reset with the retry annotation and when the shoot operation succeeds
+	RetryAttempts int `json:"retryAttempts,omitempty"`
+
+	// LastRetryTime is the time of the last Gardener retry operation requested for the Shoot
+	LastRetryTime *metav1.Time `json:"lastRetryTime,omitempty"`
+
+	// RecoveryDeadline is the time until which the Runtime with the requested deletion can be restored, the Shoot is kept hibernated until then
+	RecoveryDeadline *metav1.Time `json:"recoveryDeadline,omitempty"`
+
+	// PatchPlan contains the Shoot changes computed in the dry-run mode instead of patching the Shoot
+	PatchPlan *PatchPlan `json:"patchPlan,omitempty"`
+
+	// CredentialsRotation describes the Shoot credentials rotation driven by the Runtime Controller
+	CredentialsRotation *CredentialsRotationStatus `json:"credentialsRotation,omitempty"`
+
+	// TemporaryAdministratorsCheckTime is the time the cluster role bindings of the temporary administrators were last applied on the Shoot
+	TemporaryAdministratorsCheckTime *metav1.Time `json:"temporaryAdministratorsCheckTime,omitempty"`
+}
+
+// CredentialsRotationStatus describes the Shoot credentials rotation driven by the Runtime Controller
+type CredentialsRotationStatus struct {
+	// RequestTime is the time of the last rotation requested with the rotate-credentials annotation
+	RequestTime *metav1.Time `json:"requestTime,omitempty"`
+	// LastOperation is the last Gardener rotation operation requested for the Shoot, e.g. rotate-ca-start
+	LastOperation string `json:"lastOperation,omitempty"`
+	// LastOperationTime is the time the last rotation operation was requested
+	LastOperationTime *metav1.Time `json:"lastOperationTime,omitempty"`
+	// KubeconfigRefreshTime is the time the kubeconfig rotation was forced after the certificate authority changed
+	KubeconfigRefreshTime *metav1.Time `json:"kubeconfigRefreshTime,omitempty"`
+}
+
+// PatchPlan describes the changes the Shoot patch would apply
+type PatchPlan struct {
+	// Time when the plan was computed
+	Time metav1.Time `json:"time"`
+	// RuntimeGeneration is the generation of the Runtime the plan was computed for
+	RuntimeGeneration int64 `json:"runtimeGeneration"`
+	// TotalChanges is the number of the changed fields, only the first changes are listed
+	TotalChanges int           `json:"totalChanges"`
+	Changes      []FieldChange `json:"changes,omitempty"`
+}
+
+// FieldChange describes a single field of the Shoot changed by the patch, the values are JSON encoded
+type FieldChange struct {
+	Path    string `json:"path"`
+	Current string `json:"current,omitempty"`
+	Desired string `json:"desired,omitempty"`
+}
+
+// StateTransition is the outcome of a reconciliation
+type StateTransition struct {
+	// State is the state function which stopped or requeued the Runtime
+	State string `json:"state"`
+	// States are the state functions visited during the reconciliation, in order
+	States    []string    `json:"states,omitempty"`
+	Timestamp metav1.Time `json:"timestamp"`
+	Result    string      `json:"result,omitempty"`
+	Error     string      `json:"error,omitempty"`
+}
+
+// ShootStatus contains a subset of the Gardener Shoot spec and status
+type ShootStatus struct {
+	// ObservedGeneration is the most recent generation of the Shoot observed by Gardener
+	ObservedGeneration int64               `json:"observedGeneration,omitempty"`
+	SeedName           *string             `json:"seedName,omitempty"`
+	DNSDomain          *string             `json:"dnsDomain,omitempty"`
+	KubernetesVersion  string              `json:"kubernetesVersion,omitempty"`
+	Hibernated         bool                `json:"hibernated,omitempty"`
+	Workers            []WorkerStatus      `json:"workers,omitempty"`
+	LastOperation      *ShootLastOperation `json:"lastOperation,omitempty"`
+}
+
+// ShootLastOperation contains the type, the state and the progress of the last Shoot operation.
+// The description is not stored and the progress is rounded down, so the status is not updated whenever Gardener reports the progress.
+type ShootLastOperation struct {
+	Type  gardener.LastOperationType  `json:"type"`
+	State gardener.LastOperationState `json:"state"`
+	// Progress is the percentage of the last Shoot operation rounded down to the step of 10
+	Progress int32 `json:"progress,omitempty"`
+}
+
+type WorkerStatus struct {
+	Name         string                      `json:"name"`
+	MachineType  string                      `json:"machineType"`
+	MachineImage *gardener.ShootMachineImage `json:"machineImage,omitempty"`
 }
 
 type RuntimeShoot struct {
@@ -162,6 +290,8 @@ type RuntimeShoot struct {
 	Provider            Provider               `json:"provider"`
 	Networking          Networking             `json:"networking"`
 	ControlPlane        *gardener.ControlPlane `json:"controlPlane,omitempty"`
+	// Hibernation hibernates the Shoot when enabled, or according to the schedules with the start and end cron expressions and the time zone
+	Hibernation *gardener.Hibernation `json:"hibernation,omitempty"`
 }
 
 type Kubernetes struct {
@@ -198,8 +328,48 @@ type Networking struct {
 }
 
 type Security struct {
-	Administrators []string           `json:"administrators"`
-	Networking     NetworkingSecurity `json:"networking"`
+	Administrators []string `json:"administrators"`
+	// AccessBindings grant the cluster roles to the users, groups and service accounts
+	// +optional
+	AccessBindings []AccessBinding `json:"accessBindings,omitempty"`
+	// TemporaryAdministrators are granted the cluster-admin role until their access expires
+	// +optional
+	TemporaryAdministrators []TemporaryAdministrator `json:"temporaryAdministrators,omitempty"`
+	Networking              NetworkingSecurity       `json:"networking"`
+}
+
+// AccessBinding grants the cluster role to the user, group or service account
+// +kubebuilder:validation:XValidation:rule="!has(self.kind) || self.kind != 'ServiceAccount' || has(self.__namespace__)",message="namespace is required for ServiceAccount subjects"
+type AccessBinding struct {
+	// Kind of the subject, User, Group or ServiceAccount
+	// +kubebuilder:validation:Enum=User;Group;ServiceAccount
+	// +kubebuilder:default=User
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Namespace of the service account, ignored for the other subjects
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// ClusterRole granted to the subject, e.g. view, edit or cluster-admin
+	// +kubebuilder:validation:MinLength=1
+	ClusterRole string `json:"clusterRole"`
+}
+
+// TemporaryAdministrator describes the break-glass access of a user or group, which is revoked automatically after the expiration time
+type TemporaryAdministrator struct {
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Kind of the subject, User or Group
+	// +kubebuilder:validation:Enum=User;Group
+	// +kubebuilder:default=User
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// ExpiresAt is the time after which the access is revoked
+	ExpiresAt metav1.Time `json:"expiresAt"`
+	// Reason for granting the access, e.g. the incident number, included in the audit events
+	// +optional
+	Reason string `json:"reason,omitempty"`
 }
 
 type NetworkingSecurity struct {
@@ -0,0 +1,92 @@
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="RUNTIME",type=string,JSONPath=`.spec.runtimeName`
+//+kubebuilder:printcolumn:name="TYPE",type=string,JSONPath=`.spec.type`
+//+kubebuilder:printcolumn:name="STATE",type=string,JSONPath=`.status.state`
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// RuntimeOperation is the Schema for the runtimeoperations API
+type RuntimeOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuntimeOperationSpec   `json:"spec"`
+	Status RuntimeOperationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RuntimeOperationList contains a list of RuntimeOperation
+type RuntimeOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RuntimeOperation `json:"items"`
+}
+
+// RuntimeOperationType is the operation executed on the Runtime
+// +kubebuilder:validation:Enum=Reconcile;Retry;Maintain;RotateKubeconfig;ForcePatch
+type RuntimeOperationType string
+
+const (
+	// RuntimeOperationReconcile requests the Gardener reconciliation of the shoot
+	RuntimeOperationReconcile RuntimeOperationType = "Reconcile"
+	// RuntimeOperationRetry requests the Gardener retry of the failed shoot operation
+	RuntimeOperationRetry RuntimeOperationType = "Retry"
+	// RuntimeOperationMaintain requests the Gardener maintenance of the shoot
+	RuntimeOperationMaintain RuntimeOperationType = "Maintain"
+	// RuntimeOperationRotateKubeconfig requests the rotation of the Runtime kubeconfig
+	RuntimeOperationRotateKubeconfig RuntimeOperationType = "RotateKubeconfig"
+	// RuntimeOperationForcePatch requests patching the shoot even when the Runtime spec did not change
+	RuntimeOperationForcePatch RuntimeOperationType = "ForcePatch"
+)
+
+// RuntimeOperationSpec defines the operation requested for the Runtime
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="RuntimeOperation spec is immutable"
+type RuntimeOperationSpec struct {
+	// RuntimeName is the name of the Runtime in the namespace of the RuntimeOperation
+	RuntimeName string               `json:"runtimeName"`
+	Type        RuntimeOperationType `json:"type"`
+}
+
+type RuntimeOperationState string
+
+const (
+	RuntimeOperationStatePending    RuntimeOperationState = "Pending"
+	RuntimeOperationStateInProgress RuntimeOperationState = "InProgress"
+	RuntimeOperationStateSucceeded  RuntimeOperationState = "Succeeded"
+	RuntimeOperationStateFailed     RuntimeOperationState = "Failed"
+)
+
+// RuntimeOperationStatus defines the observed state of RuntimeOperation
+type RuntimeOperationStatus struct {
+	// State signifies current state of the operation.
+	// Value can be one of ("Pending", "InProgress", "Succeeded", "Failed").
+	State RuntimeOperationState `json:"state,omitempty"`
+
+	// StartTime is the time when the operation was requested
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the operation succeeded or failed
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Error describes why the operation failed
+	// +optional
+	Error string `json:"error,omitempty"`
+}
+
+// IsFinished returns true when the operation succeeded or failed
+func (o *RuntimeOperation) IsFinished() bool {
+	return o.Status.State == RuntimeOperationStateSucceeded || o.Status.State == RuntimeOperationStateFailed
+}
+
+func init() {
+	SchemeBuilder.Register(&RuntimeOperation{}, &RuntimeOperationList{})
+}
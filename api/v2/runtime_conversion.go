@@ -0,0 +1,174 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this Runtime to the hub version (v1).
+func (src *Runtime) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*imv1.Runtime)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	srcShoot := src.Spec.Shoot
+	dst.Spec = imv1.RuntimeSpec{
+		Shoot: imv1.RuntimeShoot{
+			Name:                srcShoot.Name,
+			Purpose:             srcShoot.Purpose,
+			PlatformRegion:      srcShoot.PlatformRegion,
+			Region:              srcShoot.Region,
+			LicenceType:         srcShoot.LicenceType,
+			SecretBindingName:   srcShoot.SecretBindingName,
+			EnforceSeedLocation: srcShoot.EnforceSeedLocation,
+			Kubernetes: imv1.Kubernetes{
+				Version: srcShoot.Kubernetes.Version,
+			},
+			Provider: imv1.Provider{
+				Type:                 srcShoot.Provider.Type,
+				ControlPlaneConfig:   srcShoot.Provider.ControlPlaneConfig,
+				InfrastructureConfig: srcShoot.Provider.InfrastructureConfig,
+			},
+			Networking:   srcShoot.Networking,
+			ControlPlane: srcShoot.ControlPlane,
+			Hibernation:  srcShoot.Hibernation,
+		},
+		Security:  src.Spec.Security,
+		ExpiresAt: src.Spec.ExpiresAt,
+	}
+
+	dst.Spec.Shoot.Provider.Workers, dst.Spec.Shoot.Provider.AdditionalWorkers = splitWorkers(srcShoot.Provider.Workers)
+	dst.Spec.Shoot.Kubernetes.KubeAPIServer = splitOidcConfigs(srcShoot.Kubernetes.KubeAPIServer.OidcConfigs)
+
+	if src.Spec.ImageRegistryCache != nil {
+		dst.Spec.Caching = &imv1.ImageRegistryCache{Enabled: src.Spec.ImageRegistryCache.Enabled}
+	}
+
+	return nil
+}
+
+// ConvertFrom converts from the hub version (v1) to this version.
+func (dst *Runtime) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*imv1.Runtime)
+
+	dst.ObjectMeta = src.ObjectMeta
+	dst.Status = src.Status
+
+	srcShoot := src.Spec.Shoot
+	dst.Spec = RuntimeSpec{
+		Shoot: RuntimeShoot{
+			Name:                srcShoot.Name,
+			Purpose:             srcShoot.Purpose,
+			PlatformRegion:      srcShoot.PlatformRegion,
+			Region:              srcShoot.Region,
+			LicenceType:         srcShoot.LicenceType,
+			SecretBindingName:   srcShoot.SecretBindingName,
+			EnforceSeedLocation: srcShoot.EnforceSeedLocation,
+			Kubernetes: Kubernetes{
+				Version: srcShoot.Kubernetes.Version,
+				KubeAPIServer: APIServer{
+					OidcConfigs: mergeOidcConfigs(srcShoot.Kubernetes.KubeAPIServer),
+				},
+			},
+			Provider: Provider{
+				Type:                 srcShoot.Provider.Type,
+				Workers:              mergeWorkers(srcShoot.Provider.Workers, srcShoot.Provider.AdditionalWorkers),
+				ControlPlaneConfig:   srcShoot.Provider.ControlPlaneConfig,
+				InfrastructureConfig: srcShoot.Provider.InfrastructureConfig,
+			},
+			Networking:   srcShoot.Networking,
+			ControlPlane: srcShoot.ControlPlane,
+			Hibernation:  srcShoot.Hibernation,
+		},
+		Security:  src.Spec.Security,
+		ExpiresAt: src.Spec.ExpiresAt,
+	}
+
+	if src.Spec.Caching != nil {
+		dst.Spec.ImageRegistryCache = &ImageRegistryCache{Enabled: src.Spec.Caching.Enabled}
+	}
+
+	return nil
+}
+
+// v1 requires exactly one main worker, all other worker pools are stored as additional workers.
+func splitWorkers(workers []gardener.Worker) ([]gardener.Worker, *[]gardener.Worker) {
+	if len(workers) == 0 {
+		return nil, nil
+	}
+
+	mainWorker := []gardener.Worker{workers[0]}
+	if len(workers) == 1 {
+		return mainWorker, nil
+	}
+
+	additionalWorkers := append([]gardener.Worker{}, workers[1:]...)
+
+	return mainWorker, &additionalWorkers
+}
+
+func mergeWorkers(workers []gardener.Worker, additionalWorkers *[]gardener.Worker) []gardener.Worker {
+	if additionalWorkers == nil || len(*additionalWorkers) == 0 {
+		return workers
+	}
+
+	return append(append([]gardener.Worker{}, workers...), *additionalWorkers...)
+}
+
+// The first OIDC config is the kube-apiserver one, all the others are stored as additional OIDC configs.
+func splitOidcConfigs(oidcConfigs []imv1.OIDCConfig) imv1.APIServer {
+	if len(oidcConfigs) == 0 {
+		return imv1.APIServer{}
+	}
+
+	apiServer := imv1.APIServer{
+		OidcConfig: oidcConfigs[0].OIDCConfig,
+	}
+
+	if len(oidcConfigs) > 1 {
+		additionalOidcConfigs := append([]imv1.OIDCConfig{}, oidcConfigs[1:]...)
+		apiServer.AdditionalOidcConfig = &additionalOidcConfigs
+	}
+
+	return apiServer
+}
+
+func mergeOidcConfigs(apiServer imv1.APIServer) []imv1.OIDCConfig {
+	oidcConfigs := []imv1.OIDCConfig{}
+	hasAdditionalOidcConfigs := apiServer.AdditionalOidcConfig != nil && len(*apiServer.AdditionalOidcConfig) > 0
+
+	if isOidcConfigEmpty(apiServer.OidcConfig) && !hasAdditionalOidcConfigs {
+		return nil
+	}
+
+	oidcConfigs = append(oidcConfigs, imv1.OIDCConfig{OIDCConfig: apiServer.OidcConfig})
+	if hasAdditionalOidcConfigs {
+		oidcConfigs = append(oidcConfigs, *apiServer.AdditionalOidcConfig...)
+	}
+
+	return oidcConfigs
+}
+
+func isOidcConfigEmpty(oidcConfig gardener.OIDCConfig) bool {
+	return oidcConfig.ClientID == nil && oidcConfig.IssuerURL == nil && oidcConfig.GroupsClaim == nil &&
+		oidcConfig.GroupsPrefix == nil && oidcConfig.UsernameClaim == nil && oidcConfig.UsernamePrefix == nil &&
+		oidcConfig.CABundle == nil && len(oidcConfig.SigningAlgs) == 0 && len(oidcConfig.RequiredClaims) == 0
+}
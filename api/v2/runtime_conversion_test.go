@@ -0,0 +1,172 @@
+package v2
+
+import (
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRuntimeConversion(t *testing.T) {
+	for tname, hub := range map[string]imv1.Runtime{
+		"Runtime with main worker only": fixHubRuntime(nil, gardener.OIDCConfig{}, nil),
+		"Runtime with additional workers": fixHubRuntime(
+			&[]gardener.Worker{fixWorker("additional-1"), fixWorker("additional-2")},
+			gardener.OIDCConfig{},
+			nil,
+		),
+		"Runtime with kube-apiserver OIDC config": fixHubRuntime(nil, fixOidcConfig("operator"), nil),
+		"Runtime with additional OIDC configs": fixHubRuntime(
+			nil,
+			fixOidcConfig("operator"),
+			&[]imv1.OIDCConfig{
+				{OIDCConfig: fixOidcConfig("ias")},
+				{OIDCConfig: fixOidcConfig("custom"), JWKS: []byte("jwks")},
+			},
+		),
+		"Runtime with additional OIDC configs only": fixHubRuntime(
+			nil,
+			gardener.OIDCConfig{},
+			&[]imv1.OIDCConfig{{OIDCConfig: fixOidcConfig("ias")}},
+		),
+	} {
+		t.Run(tname+" should survive v1 -> v2 -> v1 round trip", func(t *testing.T) {
+			// given
+			spoke := Runtime{}
+			restored := imv1.Runtime{}
+
+			// when
+			err := spoke.ConvertFrom(&hub)
+			require.NoError(t, err)
+
+			err = spoke.ConvertTo(&restored)
+			require.NoError(t, err)
+
+			// then
+			assert.Equal(t, hub, restored)
+		})
+	}
+
+	t.Run("Runtime should survive v2 -> v1 -> v2 round trip", func(t *testing.T) {
+		// given
+		hubRuntime := fixHubRuntime(&[]gardener.Worker{fixWorker("additional")}, fixOidcConfig("operator"), nil)
+		spoke := Runtime{}
+		require.NoError(t, spoke.ConvertFrom(&hubRuntime))
+
+		hub := imv1.Runtime{}
+		restored := Runtime{}
+
+		// when
+		err := spoke.ConvertTo(&hub)
+		require.NoError(t, err)
+
+		err = restored.ConvertFrom(&hub)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, spoke, restored)
+	})
+
+	t.Run("Should put main worker first and merge OIDC configs", func(t *testing.T) {
+		// given
+		hub := fixHubRuntime(
+			&[]gardener.Worker{fixWorker("additional")},
+			fixOidcConfig("operator"),
+			&[]imv1.OIDCConfig{{OIDCConfig: fixOidcConfig("ias")}},
+		)
+		spoke := Runtime{}
+
+		// when
+		err := spoke.ConvertFrom(&hub)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, spoke.Spec.Shoot.Provider.Workers, 2)
+		assert.Equal(t, "main", spoke.Spec.Shoot.Provider.Workers[0].Name)
+		assert.Equal(t, "additional", spoke.Spec.Shoot.Provider.Workers[1].Name)
+		require.Len(t, spoke.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfigs, 2)
+		assert.Equal(t, "operator", *spoke.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfigs[0].ClientID)
+		assert.Equal(t, "ias", *spoke.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfigs[1].ClientID)
+		assert.True(t, spoke.Spec.ImageRegistryCache.Enabled)
+	})
+}
+
+func fixWorker(name string) gardener.Worker {
+	return gardener.Worker{
+		Name:    name,
+		Zones:   []string{"eu-central-1a"},
+		Machine: gardener.Machine{Type: "m6i.large"},
+		Minimum: 1,
+		Maximum: 3,
+	}
+}
+
+func fixOidcConfig(clientID string) gardener.OIDCConfig {
+	return gardener.OIDCConfig{
+		ClientID:       ptr.To(clientID),
+		GroupsClaim:    ptr.To("groups"),
+		IssuerURL:      ptr.To("https://" + clientID + ".example.com"),
+		SigningAlgs:    []string{"RS256"},
+		UsernameClaim:  ptr.To("sub"),
+		UsernamePrefix: ptr.To("-"),
+	}
+}
+
+func fixHubRuntime(additionalWorkers *[]gardener.Worker, oidcConfig gardener.OIDCConfig, additionalOidcConfigs *[]imv1.OIDCConfig) imv1.Runtime {
+	return imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime-id",
+			Namespace: "kcp-system",
+			Labels:    map[string]string{imv1.LabelKymaRuntimeID: "runtime-id"},
+		},
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:              "test-shoot",
+				Purpose:           "production",
+				PlatformRegion:    "cf-eu11",
+				Region:            "eu-central-1",
+				LicenceType:       ptr.To("CUSTOMER"),
+				SecretBindingName: "secret-binding",
+				Kubernetes: imv1.Kubernetes{
+					Version: ptr.To("1.30"),
+					KubeAPIServer: imv1.APIServer{
+						OidcConfig:           oidcConfig,
+						AdditionalOidcConfig: additionalOidcConfigs,
+					},
+				},
+				Provider: imv1.Provider{
+					Type:              "aws",
+					Workers:           []gardener.Worker{fixWorker("main")},
+					AdditionalWorkers: additionalWorkers,
+				},
+				Networking: imv1.Networking{
+					Nodes:    "10.250.0.0/16",
+					Pods:     "100.64.0.0/12",
+					Services: "100.104.0.0/13",
+				},
+				Hibernation: &gardener.Hibernation{
+					Schedules: []gardener.HibernationSchedule{
+						{Start: ptr.To("00 20 * * 1-5"), End: ptr.To("00 08 * * 1-5"), Location: ptr.To("Europe/Berlin")},
+					},
+				},
+			},
+			Security: imv1.Security{
+				Administrators: []string{"admin@example.com"},
+				Networking: imv1.NetworkingSecurity{
+					Filter: imv1.Filter{Egress: imv1.Egress{Enabled: true}},
+				},
+			},
+			Caching:   &imv1.ImageRegistryCache{Enabled: true},
+			ExpiresAt: &metav1.Time{Time: time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		Status: imv1.RuntimeStatus{
+			State:                 imv1.RuntimeStateReady,
+			ProvisioningCompleted: true,
+		},
+	}
+}
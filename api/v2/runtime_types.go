@@ -0,0 +1,105 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Provider",type="string",JSONPath=".spec.shoot.provider.type"
+//+kubebuilder:printcolumn:name="Region",type="string",JSONPath=".spec.shoot.region"
+//+kubebuilder:printcolumn:name="STATE",type=string,JSONPath=`.status.state`
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// Runtime is the Schema for the runtimes API
+type Runtime struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RuntimeSpec        `json:"spec,omitempty"`
+	Status imv1.RuntimeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// RuntimeList contains a list of Runtime
+type RuntimeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Runtime `json:"items"`
+}
+
+// RuntimeSpec defines the desired state of Runtime
+type RuntimeSpec struct {
+	Shoot              RuntimeShoot        `json:"shoot"`
+	Security           imv1.Security       `json:"security"`
+	ImageRegistryCache *ImageRegistryCache `json:"imageRegistryCache,omitempty"`
+	// ExpiresAt is the time after which the Runtime is deleted automatically
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+}
+
+// ImageRegistryCache keeps the v1 shape, the caches are configured in the RegistryCache custom resources
+// and the hub version stores only the flag, so a richer shape couldn't be converted without losing data
+type ImageRegistryCache struct {
+	Enabled bool `json:"enabled"`
+}
+
+type RuntimeShoot struct {
+	Name                string                 `json:"name"`
+	Purpose             gardener.ShootPurpose  `json:"purpose"`
+	PlatformRegion      string                 `json:"platformRegion"`
+	Region              string                 `json:"region"`
+	LicenceType         *string                `json:"licenceType,omitempty"`
+	SecretBindingName   string                 `json:"secretBindingName"`
+	EnforceSeedLocation *bool                  `json:"enforceSeedLocation,omitempty"`
+	Kubernetes          Kubernetes             `json:"kubernetes,omitempty"`
+	Provider            Provider               `json:"provider"`
+	Networking          imv1.Networking        `json:"networking"`
+	ControlPlane        *gardener.ControlPlane `json:"controlPlane,omitempty"`
+	// Hibernation hibernates the Shoot when enabled, or according to the schedules with the start and end cron expressions and the time zone
+	Hibernation *gardener.Hibernation `json:"hibernation,omitempty"`
+}
+
+type Kubernetes struct {
+	Version       *string   `json:"version,omitempty"`
+	KubeAPIServer APIServer `json:"kubeAPIServer,omitempty"`
+}
+
+type APIServer struct {
+	// OidcConfigs contains all OIDC providers of the cluster.
+	// The first entry configures the kube-apiserver (its jwks is not supported), the remaining ones are additional providers.
+	OidcConfigs []imv1.OIDCConfig `json:"oidcConfigs,omitempty"`
+}
+
+type Provider struct {
+	//+kubebuilder:validation:Enum=aws;azure;gcp;openstack
+	Type string `json:"type"`
+	// Workers contains all worker pools of the cluster. The first entry is the main worker pool.
+	//+kubebuilder:validation:MinItems=1
+	Workers              []gardener.Worker     `json:"workers"`
+	ControlPlaneConfig   *runtime.RawExtension `json:"controlPlaneConfig,omitempty"`
+	InfrastructureConfig *runtime.RawExtension `json:"infrastructureConfig,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Runtime{}, &RuntimeList{})
+}
@@ -18,13 +18,12 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"flag"
 	"fmt"
+	"github.com/kyma-project/infrastructure-manager/internal/configwatch"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/customconfig"
 	registrycache2 "github.com/kyma-project/infrastructure-manager/internal/registrycache"
 	registrycache "github.com/kyma-project/kim-snatch/api/v1beta1"
-	"io"
 	"os"
 	"time"
 
@@ -32,17 +31,19 @@ import (
 	gardener_apis "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
 	gardener_oidc "github.com/gardener/oidc-webhook-authenticator/apis/authentication/v1alpha1"
 	"github.com/go-logr/logr"
-	validator "github.com/go-playground/validator/v10"
 	infrastructuremanagerv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	infrastructuremanagerv2 "github.com/kyma-project/infrastructure-manager/api/v2"
 	kubeconfig_controller "github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
 	runtime_controller "github.com/kyma-project/infrastructure-manager/internal/controller/runtime"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm"
-	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/runtimeoperation"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
+	webhookv1 "github.com/kyma-project/infrastructure-manager/internal/webhook/v1"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/kubeconfig"
-	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8slabels "k8s.io/apimachinery/pkg/labels"
@@ -68,6 +69,7 @@ var (
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(infrastructuremanagerv1.AddToScheme(scheme))
+	utilruntime.Must(infrastructuremanagerv2.AddToScheme(scheme))
 	utilruntime.Must(rbacv1.AddToScheme(scheme))
 	utilruntime.Must(gardener_oidc.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
@@ -86,8 +88,12 @@ const (
 	defaultShootCreateRequeueDuration    = 60 * time.Second
 	defaultShootDeleteRequeueDuration    = 90 * time.Second
 	defaultShootReconcileRequeueDuration = 30 * time.Second
+	defaultShootWatchRequeueDuration     = 5 * time.Minute
 	defaultRuntimeCtrlWorkersCnt         = 25
 	defaultGardenerClusterCtrlWorkersCnt = 25
+	defaultTracingSamplingRatio          = 1.0
+	defaultConfigReloadInterval          = 30 * time.Second
+	tracingShutdownTimeout               = 5 * time.Second
 )
 
 func main() {
@@ -108,6 +114,12 @@ func main() {
 	var auditLogMandatory bool
 	var structuredAuthEnabled bool
 	var customConfigControllerEnabled bool
+	var runtimeOperationControllerEnabled bool
+	var webhooksEnabled bool
+	var tracingConfig tracing.Config
+	var configReloadInterval time.Duration
+	var shootWatchEnabled bool
+	var patchDryRun bool
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
@@ -128,6 +140,14 @@ func main() {
 	flag.BoolVar(&auditLogMandatory, "audit-log-mandatory", true, "Feature flag to enable strict mode for audit log configuration")
 	flag.BoolVar(&structuredAuthEnabled, "structured-auth-enabled", false, "Feature flag to enable structured authentication")
 	flag.BoolVar(&customConfigControllerEnabled, "custom-config-controller-enabled", false, "Feature flag to custom config controller")
+	flag.StringVar(&tracingConfig.OTLPEndpoint, "tracing-otlp-endpoint", "", "The host:port of the OTLP gRPC collector receiving traces. Tracing is disabled when empty")
+	flag.BoolVar(&tracingConfig.Insecure, "tracing-otlp-insecure", false, "Disables TLS for the connection to the OTLP collector")
+	flag.Float64Var(&tracingConfig.SamplingRatio, "tracing-sampling-ratio", defaultTracingSamplingRatio, "The ratio of reconciliations which are traced")
+	flag.DurationVar(&configReloadInterval, "config-reload-interval", defaultConfigReloadInterval, "Interval of checking the converter, audit log and maintenance window configuration files for changes. Reloading is disabled when set to 0")
+	flag.BoolVar(&shootWatchEnabled, "shoot-watch-enabled", false, "Feature flag to reconcile Runtime resources on shoot changes instead of polling Gardener")
+	flag.BoolVar(&patchDryRun, "patch-dry-run", false, "Feature flag to store the planned shoot patch changes in the Runtime status instead of patching the shoots. Other Gardener operations, like retries, credentials rotations and deletions, are not affected")
+	flag.BoolVar(&webhooksEnabled, "webhooks-enabled", false, "Feature flag to enable admission webhooks for Runtime resources")
+	flag.BoolVar(&runtimeOperationControllerEnabled, "runtime-operation-controller-enabled", false, "Feature flag to enable the controller executing RuntimeOperation resources")
 
 	opts := zap.Options{}
 	opts.BindFlags(flag.CommandLine)
@@ -136,6 +156,12 @@ func main() {
 	logger := zap.New(zap.UseFlagOptions(&opts))
 	ctrl.SetLogger(logger)
 
+	shutdownTracing, err := tracing.Setup(context.Background(), tracingConfig)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	restConfig := ctrl.GetConfigOrDie()
 
 	mgr, err := ctrl.NewManager(restConfig, ctrl.Options{
@@ -194,26 +220,37 @@ func main() {
 		os.Exit(1)
 	}
 
-	// load converter configuration
-	getReader := func() (io.Reader, error) {
-		return os.Open(converterConfigFilepath)
-	}
-	var config config.Config
-	if err = config.Load(getReader); err != nil {
-		setupLog.Error(err, "unable to load converter configuration")
-		os.Exit(1)
-	}
-
-	validate := validator.New(validator.WithRequiredStructEnabled())
-	if err = validate.Struct(config); err != nil {
+	// load converter configuration, the audit log tenant and the maintenance window files are watched for changes
+	var requeueBackoff *fsm.RequeueBackoff
+	var driftRateLimiter *rate.Limiter
+	var rotationRateLimiter *rate.Limiter
+	configWatcher, err := configwatch.NewWatcher(logger.WithName("config-watcher"), configwatch.Options{
+		ConverterConfigPath: converterConfigFilepath,
+		Interval:            configReloadInterval,
+		Metrics:             metrics,
+		EventRecorder:       mgr.GetEventRecorderFor("infrastructure-manager"),
+		Pod:                 podReference(),
+		OnReload: func(snapshot configwatch.Snapshot) {
+			requeueBackoff.SetConfig(snapshot.Config.BackoffConfig)
+			fsm.SetDriftRateLimit(driftRateLimiter, snapshot.Config.DriftDetection)
+			fsm.SetRotationRateLimit(rotationRateLimiter, snapshot.Config.CredentialsRotation)
+		},
+	})
+	if err != nil {
 		setupLog.Error(err, "invalid converter configuration")
 		os.Exit(1)
 	}
 
-	auditLogDataMap, err := loadAuditLogDataMap(config.ConverterConfig.AuditLog.TenantConfigPath)
-	if err != nil {
-		setupLog.Error(err, "invalid audit log tenant configuration")
-		os.Exit(1)
+	snapshot := configWatcher.Snapshot()
+	requeueBackoff = fsm.NewRequeueBackoff(snapshot.Config.BackoffConfig)
+	driftRateLimiter = fsm.NewDriftRateLimiter(snapshot.Config.DriftDetection)
+	rotationRateLimiter = fsm.NewRotationRateLimiter(snapshot.Config.CredentialsRotation)
+
+	if configReloadInterval > 0 {
+		if err = mgr.Add(configWatcher); err != nil {
+			setupLog.Error(err, "unable to set up configuration watcher")
+			os.Exit(1)
+		}
 	}
 
 	cfg := fsm.RCCfg{
@@ -224,25 +261,73 @@ func main() {
 		ControlPlaneRequeueDuration:   defaultControlPlaneRequeueDuration,
 		Finalizer:                     infrastructuremanagerv1.Finalizer,
 		ShootNamesapace:               gardenerNamespace,
-		Config:                        config,
+		Config:                        snapshot.Config,
 		AuditLogMandatory:             auditLogMandatory,
 		Metrics:                       metrics,
-		AuditLogging:                  auditLogDataMap,
+		AuditLogging:                  snapshot.AuditLogging,
 		StructuredAuthEnabled:         structuredAuthEnabled,
+		RequeueBackoff:                requeueBackoff,
+		DriftRateLimiter:              driftRateLimiter,
+		RotationRateLimiter:           rotationRateLimiter,
+		PatchDryRun:                   patchDryRun,
+	}
+
+	var shootCache cache.Cache
+	if shootWatchEnabled {
+		shootCache, err = initShootCache(gardenerKubeconfigPath, gardenerNamespace)
+		if err != nil {
+			setupLog.Error(err, "unable to initialize shoot cache", "controller", "Runtime")
+			os.Exit(1)
+		}
+
+		if err = mgr.Add(shootCache); err != nil {
+			setupLog.Error(err, "unable to set up shoot cache", "controller", "Runtime")
+			os.Exit(1)
+		}
+
+		// shoot changes trigger the reconciliation, polling is kept only in case any event is missed
+		cfg.RequeueDurationShootCreate = defaultShootWatchRequeueDuration
+		cfg.RequeueDurationShootDelete = defaultShootWatchRequeueDuration
+		cfg.RequeueDurationShootReconcile = defaultShootWatchRequeueDuration
 	}
 
 	runtimeReconciler := runtime_controller.NewRuntimeReconciler(
 		mgr,
-		gardenerClient,
+		tracing.NewClient(gardenerClient, "gardener"),
 		logger,
 		cfg,
 	)
 
+	if configReloadInterval > 0 {
+		runtimeReconciler.ConfigWatcher = configWatcher
+	}
+	runtimeReconciler.ShootCache = shootCache
+
 	if err = runtimeReconciler.SetupWithManager(mgr, runtimeCtrlWorkersCnt); err != nil {
 		setupLog.Error(err, "unable to setup controller with Manager", "controller", "Runtime")
 		os.Exit(1)
 	}
 
+	if runtimeOperationControllerEnabled {
+		runtimeOperationReconciler := runtimeoperation.NewRuntimeOperationReconciler(
+			mgr,
+			tracing.NewClient(gardenerClient, "gardener"),
+			gardenerNamespace,
+			logger,
+		)
+		if err = runtimeOperationReconciler.SetupWithManager(mgr, 1); err != nil {
+			setupLog.Error(err, "unable to setup controller with Manager", "controller", "RuntimeOperation")
+			os.Exit(1)
+		}
+	}
+
+	if webhooksEnabled {
+		if err = webhookv1.SetupRuntimeWebhookWithManager(mgr, configWatcher); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Runtime")
+			os.Exit(1)
+		}
+	}
+
 	//+kubebuilder:scaffold:builder
 
 	if err = mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -272,6 +357,12 @@ func main() {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		setupLog.Error(err, "unable to flush traces")
+	}
 }
 
 func initGardenerClients(kubeconfigPath string, namespace string, timeout time.Duration, rlQPS, rlBurst int) (client.Client, gardener_apis.ShootInterface, client.SubResourceClient, error) {
@@ -314,27 +405,39 @@ func initGardenerClients(kubeconfigPath string, namespace string, timeout time.D
 	return gardenerClient, shootClient, dynamicKubeconfigAPI, nil
 }
 
-func loadAuditLogDataMap(p string) (auditlogs.Configuration, error) {
-	file, err := os.Open(p)
+// initShootCache creates the cache of the shoots in the Gardener project namespace, it has to be started by the manager
+func initShootCache(kubeconfigPath string, namespace string) (cache.Cache, error) {
+	restConfig, err := gardener.NewRestConfigFromFile(kubeconfigPath)
 	if err != nil {
 		return nil, err
 	}
 
-	var data auditlogs.Configuration
-	if err := json.NewDecoder(file).Decode(&data); err != nil {
-		return nil, err
+	shootScheme := runtime.NewScheme()
+	if err = v1beta1.AddToScheme(shootScheme); err != nil {
+		return nil, errors.Wrap(err, "failed to register Gardener schema")
 	}
-	validate := validator.New(validator.WithRequiredStructEnabled())
 
-	for _, nestedMap := range data {
-		for _, auditLogData := range nestedMap {
-			if err := validate.Struct(auditLogData); err != nil {
-				return nil, err
-			}
-		}
+	return cache.New(restConfig, cache.Options{
+		Scheme: shootScheme,
+		DefaultNamespaces: map[string]cache.Config{
+			namespace: {},
+		},
+	})
+}
+
+// podReference returns the manager Pod exposed with the Downward API, the configuration reload events are recorded for it
+func podReference() *corev1.ObjectReference {
+	name, namespace := os.Getenv("POD_NAME"), os.Getenv("POD_NAMESPACE")
+	if name == "" || namespace == "" {
+		return nil
 	}
 
-	return data, nil
+	return &corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       name,
+		Namespace:  namespace,
+	}
 }
 
 func refreshRuntimeMetrics(restConfig *rest.Config, logger logr.Logger, metrics metrics.Metrics) {
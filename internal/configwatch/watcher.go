@@ -0,0 +1,214 @@
+package configwatch
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/go-playground/validator/v10"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/maintenance"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+const (
+	ReloadResultSuccess  = "success"
+	ReloadResultRejected = "rejected"
+
+	EventReasonConfigReloaded       = "ConfigReloaded"
+	EventReasonConfigReloadRejected = "ConfigReloadRejected"
+)
+
+// Snapshot contains the configuration files loaded and validated together
+type Snapshot struct {
+	Config             config.Config
+	AuditLogging       auditlogs.Configuration
+	MaintenanceWindows maintenance.Windows
+}
+
+type Options struct {
+	ConverterConfigPath string
+	Interval            time.Duration
+	Metrics             metrics.Metrics
+	EventRecorder       record.EventRecorder
+	// Pod is the object the reload events are recorded for, events are not recorded when not set
+	Pod *corev1.ObjectReference
+	// OnReload is called after the new snapshot was swapped
+	OnReload func(Snapshot)
+}
+
+// Watcher polls the converter configuration file together with the audit log tenant and the maintenance window files it points to.
+// The changed files are validated and swapped atomically, invalid changes are rejected and the previous snapshot is kept.
+type Watcher struct {
+	Options
+	log      logr.Logger
+	current  atomic.Pointer[Snapshot]
+	mu       sync.Mutex
+	checksum []byte
+}
+
+var _ manager.Runnable = &Watcher{}
+var _ manager.LeaderElectionRunnable = &Watcher{}
+
+// NewWatcher loads the initial snapshot, an error is returned when the configuration is invalid
+func NewWatcher(log logr.Logger, opts Options) (*Watcher, error) {
+	w := &Watcher{
+		Options: opts,
+		log:     log,
+	}
+
+	snapshot, checksum, err := w.load()
+	if err != nil {
+		return nil, err
+	}
+
+	w.current.Store(snapshot)
+	w.checksum = checksum
+
+	return w, nil
+}
+
+// Snapshot returns the last valid configuration
+func (w *Watcher) Snapshot() Snapshot {
+	return *w.current.Load()
+}
+
+// Start polls the configuration files until the context is cancelled
+func (w *Watcher) Start(ctx context.Context) error {
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			w.Reload()
+		}
+	}
+}
+
+// NeedLeaderElection returns false, all replicas have to reload their configuration
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Reload loads the configuration files and swaps the snapshot if they changed and are valid
+func (w *Watcher) Reload() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	snapshot, checksum, err := w.load()
+	if bytes.Equal(checksum, w.checksum) {
+		return
+	}
+	// the checksum of the rejected files is kept as well, so they are reported only once
+	w.checksum = checksum
+
+	if err != nil {
+		w.log.Error(err, "configuration change rejected, keeping the previous configuration")
+		w.Metrics.IncConfigReloadCounter(ReloadResultRejected)
+		w.recordEvent(corev1.EventTypeWarning, EventReasonConfigReloadRejected, "Configuration change rejected: "+err.Error())
+		return
+	}
+
+	w.current.Store(snapshot)
+	w.log.Info("configuration reloaded")
+	w.Metrics.IncConfigReloadCounter(ReloadResultSuccess)
+	w.recordEvent(corev1.EventTypeNormal, EventReasonConfigReloaded, "Configuration reloaded")
+
+	if w.OnReload != nil {
+		w.OnReload(*snapshot)
+	}
+}
+
+func (w *Watcher) recordEvent(eventType, reason, message string) {
+	if w.EventRecorder == nil || w.Pod == nil {
+		return
+	}
+	w.EventRecorder.Event(w.Pod, eventType, reason, message)
+}
+
+// load reads and validates all files, the checksum is returned also for invalid files
+func (w *Watcher) load() (*Snapshot, []byte, error) {
+	hash := sha256.New()
+
+	converterData, err := os.ReadFile(w.ConverterConfigPath)
+	if err != nil {
+		hash.Write([]byte(err.Error()))
+		return nil, hash.Sum(nil), errors.Wrap(err, "unable to read converter configuration")
+	}
+	hash.Write(converterData)
+
+	var cfg config.Config
+	if err := cfg.Load(func() (io.Reader, error) {
+		return bytes.NewReader(converterData), nil
+	}); err != nil {
+		return nil, hash.Sum(nil), errors.Wrap(err, "unable to decode converter configuration")
+	}
+
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	if err := validate.Struct(cfg); err != nil {
+		return nil, hash.Sum(nil), errors.Wrap(err, "invalid converter configuration")
+	}
+
+	auditLogData, err := os.ReadFile(cfg.ConverterConfig.AuditLog.TenantConfigPath)
+	if err != nil {
+		return nil, hash.Sum(nil), errors.Wrap(err, "unable to read audit log tenant configuration")
+	}
+	hash.Write(auditLogData)
+
+	auditLogging, err := decodeAuditLogConfiguration(validate, auditLogData)
+	if err != nil {
+		return nil, hash.Sum(nil), errors.Wrap(err, "invalid audit log tenant configuration")
+	}
+
+	// an invalid maintenance window file is not rejected, the file is then read and reported on every reconciliation of the production runtimes
+	var windows maintenance.Windows
+	if cfg.ConverterConfig.MaintenanceWindow.WindowMapPath != "" {
+		windowsData, err := os.ReadFile(cfg.ConverterConfig.MaintenanceWindow.WindowMapPath)
+		if err != nil {
+			hash.Write([]byte(err.Error()))
+		} else {
+			hash.Write(windowsData)
+			if err := json.Unmarshal(windowsData, &windows); err != nil {
+				windows = nil
+			}
+		}
+	}
+
+	return &Snapshot{
+		Config:             cfg,
+		AuditLogging:       auditLogging,
+		MaintenanceWindows: windows,
+	}, hash.Sum(nil), nil
+}
+
+func decodeAuditLogConfiguration(validate *validator.Validate, data []byte) (auditlogs.Configuration, error) {
+	var auditLogging auditlogs.Configuration
+	if err := json.Unmarshal(data, &auditLogging); err != nil {
+		return nil, err
+	}
+
+	for _, nestedMap := range auditLogging {
+		for _, auditLogData := range nestedMap {
+			if err := validate.Struct(auditLogData); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return auditLogging, nil
+}
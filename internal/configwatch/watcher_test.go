@@ -0,0 +1,189 @@
+package configwatch
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+const converterConfigTemplate = `{
+  "converter": {
+    "kubernetes": {
+      "defaultVersion": "%s",
+      "defaultOperatorOidc": {
+        "clientID": "client-id",
+        "groupsClaim": "groups",
+        "issuerURL": "https://issuer.example.com",
+        "signingAlgs": ["RS256"],
+        "usernameClaim": "sub",
+        "usernamePrefix": "-"
+      }
+    },
+    "machineImage": {"defaultName": "gardenlinux", "defaultVersion": "1592.1.0"},
+    "gardener": {"projectName": "kyma-dev"},
+    "auditLogging": {"policyConfigMapName": "policy-config-map", "tenantConfigPath": "%s"},
+    "maintenanceWindow": {"windowMapPath": "%s"}
+  },
+  "cluster": {
+    "defaultSharedIASTenant": {
+      "clientID": "client-id",
+      "groupsClaim": "groups",
+      "issuerURL": "https://ias.example.com",
+      "signingAlgs": ["RS256"],
+      "usernameClaim": "sub",
+      "usernamePrefix": "-"
+    }
+  }
+}`
+
+const auditLogConfig = `{"aws": {"eu-central-1": {"tenantID": "tenant", "serviceURL": "https://auditlog.example.com", "secretName": "auditlog-secret"}}}`
+
+const maintenanceWindowConfig = `{"eu-central-1": {"begin": "010000+0000", "end": "020000+0000"}}`
+
+type configFiles struct {
+	converter   string
+	auditLog    string
+	maintenance string
+}
+
+func writeConfigFiles(t *testing.T, dir, kubernetesVersion string) configFiles {
+	files := configFiles{
+		converter:   filepath.Join(dir, "converter_config.json"),
+		auditLog:    filepath.Join(dir, "audit_log.json"),
+		maintenance: filepath.Join(dir, "maintenance_window.json"),
+	}
+
+	converterConfig := fmt.Sprintf(converterConfigTemplate, kubernetesVersion, files.auditLog, files.maintenance)
+	require.NoError(t, os.WriteFile(files.converter, []byte(converterConfig), 0600))
+	require.NoError(t, os.WriteFile(files.auditLog, []byte(auditLogConfig), 0600))
+	require.NoError(t, os.WriteFile(files.maintenance, []byte(maintenanceWindowConfig), 0600))
+
+	return files
+}
+
+func newTestWatcher(t *testing.T, converterPath string, m *mocks.Metrics, recorder record.EventRecorder) (*Watcher, error) {
+	return NewWatcher(logr.Discard(), Options{
+		ConverterConfigPath: converterPath,
+		Metrics:             m,
+		EventRecorder:       recorder,
+		Pod:                 &corev1.ObjectReference{Kind: "Pod", Name: "infrastructure-manager", Namespace: "kcp-system"},
+	})
+}
+
+func TestWatcher(t *testing.T) {
+	t.Run("Should load initial snapshot", func(t *testing.T) {
+		// given
+		files := writeConfigFiles(t, t.TempDir(), "1.31")
+
+		// when
+		watcher, err := newTestWatcher(t, files.converter, &mocks.Metrics{}, record.NewFakeRecorder(1))
+
+		// then
+		require.NoError(t, err)
+		snapshot := watcher.Snapshot()
+		assert.Equal(t, "1.31", snapshot.Config.ConverterConfig.Kubernetes.DefaultVersion)
+		assert.Contains(t, snapshot.AuditLogging, "aws")
+		window, err := snapshot.MaintenanceWindows.GetMaintenanceWindow("eu-central-1")
+		require.NoError(t, err)
+		assert.Equal(t, "010000+0000", window.Begin)
+	})
+
+	t.Run("Should fail when initial configuration is invalid", func(t *testing.T) {
+		// given
+		files := writeConfigFiles(t, t.TempDir(), "")
+
+		// when
+		_, err := newTestWatcher(t, files.converter, &mocks.Metrics{}, record.NewFakeRecorder(1))
+
+		// then
+		require.Error(t, err)
+	})
+
+	t.Run("Should swap snapshot when configuration changed", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		files := writeConfigFiles(t, dir, "1.31")
+		m := &mocks.Metrics{}
+		m.On("IncConfigReloadCounter", ReloadResultSuccess).Return().Once()
+		recorder := record.NewFakeRecorder(1)
+		watcher, err := newTestWatcher(t, files.converter, m, recorder)
+		require.NoError(t, err)
+
+		var reloaded Snapshot
+		watcher.OnReload = func(snapshot Snapshot) {
+			reloaded = snapshot
+		}
+
+		// when
+		writeConfigFiles(t, dir, "1.32")
+		watcher.Reload()
+		// unchanged files are not reloaded again
+		watcher.Reload()
+
+		// then
+		assert.Equal(t, "1.32", watcher.Snapshot().Config.ConverterConfig.Kubernetes.DefaultVersion)
+		assert.Equal(t, "1.32", reloaded.Config.ConverterConfig.Kubernetes.DefaultVersion)
+		assert.True(t, strings.HasPrefix(<-recorder.Events, "Normal "+EventReasonConfigReloaded))
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Should reject invalid audit log configuration and keep previous snapshot", func(t *testing.T) {
+		// given
+		files := writeConfigFiles(t, t.TempDir(), "1.31")
+		m := &mocks.Metrics{}
+		m.On("IncConfigReloadCounter", ReloadResultRejected).Return().Once()
+		recorder := record.NewFakeRecorder(1)
+		watcher, err := newTestWatcher(t, files.converter, m, recorder)
+		require.NoError(t, err)
+
+		// when
+		require.NoError(t, os.WriteFile(files.auditLog, []byte(`{"aws": {"eu-central-1": {"tenantID": "tenant"}}}`), 0600))
+		watcher.Reload()
+		// rejected files are reported only once
+		watcher.Reload()
+
+		// then
+		assert.Equal(t, "https://auditlog.example.com", watcher.Snapshot().AuditLogging["aws"]["eu-central-1"].ServiceURL)
+		assert.True(t, strings.HasPrefix(<-recorder.Events, "Warning "+EventReasonConfigReloadRejected))
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Should not reject invalid maintenance window configuration", func(t *testing.T) {
+		// given
+		files := writeConfigFiles(t, t.TempDir(), "1.31")
+		m := &mocks.Metrics{}
+		m.On("IncConfigReloadCounter", ReloadResultSuccess).Return().Once()
+		watcher, err := newTestWatcher(t, files.converter, m, nil)
+		require.NoError(t, err)
+
+		// when
+		require.NoError(t, os.WriteFile(files.maintenance, []byte(`{"eu-central-1": `), 0600))
+		watcher.Reload()
+
+		// then
+		assert.Nil(t, watcher.Snapshot().MaintenanceWindows)
+		m.AssertExpectations(t)
+	})
+
+	t.Run("Should not fail when maintenance window configuration is missing", func(t *testing.T) {
+		// given
+		files := writeConfigFiles(t, t.TempDir(), "1.31")
+		require.NoError(t, os.Remove(files.maintenance))
+
+		// when
+		watcher, err := newTestWatcher(t, files.converter, &mocks.Metrics{}, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, watcher.Snapshot().MaintenanceWindows)
+	})
+}
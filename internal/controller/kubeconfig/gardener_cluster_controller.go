@@ -41,7 +41,7 @@ import (
 
 const (
 	lastKubeconfigSyncAnnotation      = "operator.kyma-project.io/last-sync"
-	forceKubeconfigRotationAnnotation = "operator.kyma-project.io/force-kubeconfig-rotation"
+	ForceKubeconfigRotationAnnotation = "operator.kyma-project.io/force-kubeconfig-rotation"
 	clusterCRNameLabel                = "operator.kyma-project.io/cluster-name"
 
 	rotationPeriodRatio = 0.95
@@ -319,7 +319,7 @@ func secretRotationForced(cluster *imv1.GardenerCluster) bool {
 		return false
 	}
 
-	_, found := annotations[forceKubeconfigRotationAnnotation]
+	_, found := annotations[ForceKubeconfigRotationAnnotation]
 	return found
 }
 
@@ -398,7 +398,7 @@ func (controller *GardenerClusterController) removeForceRotationAnnotation(ctx c
 		}
 
 		annotations := clusterToUpdate.GetAnnotations()
-		delete(annotations, forceKubeconfigRotationAnnotation)
+		delete(annotations, ForceKubeconfigRotationAnnotation)
 		clusterToUpdate.SetAnnotations(annotations)
 
 		return controller.Update(ctx, &clusterToUpdate)
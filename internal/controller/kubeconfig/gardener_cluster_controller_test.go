@@ -171,7 +171,7 @@ var _ = Describe("Gardener Cluster controller", func() {
 				}
 
 				readyState := newGardenerCluster.Status.State == imv1.ReadyState
-				_, forceRotationAnnotationFound := newGardenerCluster.GetAnnotations()[forceKubeconfigRotationAnnotation]
+				_, forceRotationAnnotationFound := newGardenerCluster.GetAnnotations()[ForceKubeconfigRotationAnnotation]
 
 				return readyState && !forceRotationAnnotationFound
 			}, time.Second*45, time.Second*3).Should(BeTrue())
@@ -384,7 +384,7 @@ func fixGardenerClusterCR(kymaName, namespace, shootName, secretName string) imv
 }
 
 func fixGardenerClusterCRWithForceRotationAnnotation(kymaName, namespace, shootName, secretName string) imv1.GardenerCluster {
-	annotations := map[string]string{forceKubeconfigRotationAnnotation: "true"}
+	annotations := map[string]string{ForceKubeconfigRotationAnnotation: "true"}
 
 	return newTestGardenerClusterCR(kymaName, namespace, shootName, secretName).
 		WithLabels(fixGardenerClusterLabels(kymaName, shootName)).
@@ -22,8 +22,19 @@ const (
 	GardenerClusterStateMetricName = "im_gardener_clusters_state"
 	RuntimeStateMetricName         = "im_runtime_state"
 	RuntimeFSMStopMetricName       = "unexpected_stops_total"
+	RuntimeFSMStateDurationName    = "im_runtime_fsm_state_duration_seconds"
+	RuntimeFSMStateOutcomesName    = "im_runtime_fsm_state_outcomes_total"
+	RuntimeTimeToReadyMetricName   = "im_runtime_time_to_ready_seconds"
+	RuntimeOperationTimeoutsName   = "im_runtime_operation_timeouts_total"
+	ConfigReloadsMetricName        = "im_config_reloads_total"
+	RuntimeShootDriftMetricName    = "im_runtime_shoot_drift_fields"
+	RuntimeRemainingLifetimeName   = "im_runtime_remaining_lifetime_seconds"
 	provider                       = "provider"
 	state                          = "state"
+	stateFn                        = "stateFn"
+	outcome                        = "outcome"
+	operation                      = "operation"
+	result                         = "result"
 	reason                         = "reason"
 	message                        = "message"
 	KubeconfigExpirationMetricName = "im_kubeconfig_expiration"
@@ -37,6 +48,12 @@ type Metrics interface {
 	CleanUpRuntimeGauge(runtimeID, runtimeName string)
 	ResetRuntimeMetrics()
 	IncRuntimeFSMStopCounter()
+	ObserveRuntimeFSMState(stateFnName, outcome string, duration time.Duration)
+	ObserveRuntimeTimeToReady(runtime v1.Runtime, duration time.Duration)
+	IncRuntimeOperationTimeoutCounter(runtime v1.Runtime, operation string)
+	IncConfigReloadCounter(result string)
+	SetRuntimeShootDrift(runtime v1.Runtime, driftedFields int)
+	SetRuntimeRemainingLifetime(runtime v1.Runtime, remaining time.Duration)
 	SetGardenerClusterStates(cluster v1.GardenerCluster)
 	CleanUpGardenerClusterGauge(runtimeID string)
 	CleanUpKubeconfigExpiration(runtimeID string)
@@ -48,6 +65,13 @@ type metricsImpl struct {
 	kubeconfigExpirationGauge     *prometheus.GaugeVec
 	runtimeStateGauge             *prometheus.GaugeVec
 	runtimeFSMUnexpectedStopsCnt  prometheus.Counter
+	runtimeFSMStateDuration       *prometheus.HistogramVec
+	runtimeFSMStateOutcomesCnt    *prometheus.CounterVec
+	runtimeTimeToReady            *prometheus.HistogramVec
+	runtimeOperationTimeoutsCnt   *prometheus.CounterVec
+	configReloadsCnt              *prometheus.CounterVec
+	runtimeShootDriftGauge        *prometheus.GaugeVec
+	runtimeRemainingLifetimeGauge *prometheus.GaugeVec
 }
 
 func NewMetrics() Metrics {
@@ -75,8 +99,64 @@ func NewMetrics() Metrics {
 				Name: RuntimeFSMStopMetricName,
 				Help: "Exposes the number of unexpected state machine stop events",
 			}),
+		runtimeFSMStateDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: componentName,
+				Name:      RuntimeFSMStateDurationName,
+				Help:      "Exposes the execution time of the Runtime state machine functions, including the status update they request",
+				Buckets:   prometheus.DefBuckets,
+			}, []string{stateFn, outcome}),
+		runtimeFSMStateOutcomesCnt: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: componentName,
+				Name:      RuntimeFSMStateOutcomesName,
+				Help:      "Exposes the number of Runtime state machine function executions by outcome (switch, requeue, stop, error), the outcome of the requested status update is counted against the requesting function",
+			}, []string{stateFn, outcome}),
+		runtimeTimeToReady: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Subsystem: componentName,
+				Name:      RuntimeTimeToReadyMetricName,
+				Help:      "Exposes the time from the Runtime CR creation to the completed provisioning",
+				Buckets:   []float64{300, 600, 900, 1200, 1500, 1800, 2400, 3000, 3600, 5400, 7200},
+			}, []string{provider}),
+		runtimeOperationTimeoutsCnt: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: componentName,
+				Name:      RuntimeOperationTimeoutsName,
+				Help:      "Exposes the number of shoot operations (create, update, delete) which exceeded the configured timeout",
+			}, []string{runtimeIDKeyName, operation, provider}),
+		configReloadsCnt: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Subsystem: componentName,
+				Name:      ConfigReloadsMetricName,
+				Help:      "Exposes the number of configuration reloads by result (success, rejected)",
+			}, []string{result}),
+		runtimeShootDriftGauge: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Subsystem: componentName,
+				Name:      RuntimeShootDriftMetricName,
+				Help:      "Exposes the number of shoot fields which differ from the Runtime CR spec",
+			}, []string{runtimeIDKeyName, runtimeNameKeyName, shootNameIDKeyName}),
+		runtimeRemainingLifetimeGauge: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Subsystem: componentName,
+				Name:      RuntimeRemainingLifetimeName,
+				Help:      "Exposes the time remaining until the expiration of the Runtime CR, measured at the last reconciliation",
+			}, []string{runtimeIDKeyName, runtimeNameKeyName, shootNameIDKeyName, provider}),
 	}
-	ctrlMetrics.Registry.MustRegister(m.gardenerClustersStateGaugeVec, m.kubeconfigExpirationGauge, m.runtimeStateGauge, m.runtimeFSMUnexpectedStopsCnt)
+	ctrlMetrics.Registry.MustRegister(
+		m.gardenerClustersStateGaugeVec,
+		m.kubeconfigExpirationGauge,
+		m.runtimeStateGauge,
+		m.runtimeFSMUnexpectedStopsCnt,
+		m.runtimeFSMStateDuration,
+		m.runtimeFSMStateOutcomesCnt,
+		m.runtimeTimeToReady,
+		m.runtimeOperationTimeoutsCnt,
+		m.configReloadsCnt,
+		m.runtimeShootDriftGauge,
+		m.runtimeRemainingLifetimeGauge,
+	)
 	return m
 }
 
@@ -91,12 +171,27 @@ func (m metricsImpl) SetRuntimeStates(runtime v1.Runtime) {
 			reason = runtime.Status.Conditions[size-1].Message
 		}
 
-		m.CleanUpRuntimeGauge(runtimeID, runtime.Name)
+		m.cleanUpRuntimeStateGauge(runtimeID, runtime.Name)
 		m.runtimeStateGauge.WithLabelValues(runtimeID, runtime.Name, runtime.Spec.Shoot.Name, runtime.Spec.Shoot.Provider.Type, string(runtime.Status.State), reason).Set(1)
 	}
 }
 
 func (m metricsImpl) CleanUpRuntimeGauge(runtimeID, runtimeName string) {
+	m.cleanUpRuntimeStateGauge(runtimeID, runtimeName)
+	m.runtimeShootDriftGauge.DeletePartialMatch(prometheus.Labels{
+		runtimeIDKeyName:   runtimeID,
+		runtimeNameKeyName: runtimeName,
+	})
+	m.runtimeRemainingLifetimeGauge.DeletePartialMatch(prometheus.Labels{
+		runtimeIDKeyName:   runtimeID,
+		runtimeNameKeyName: runtimeName,
+	})
+	m.runtimeOperationTimeoutsCnt.DeletePartialMatch(prometheus.Labels{
+		runtimeIDKeyName: runtimeID,
+	})
+}
+
+func (m metricsImpl) cleanUpRuntimeStateGauge(runtimeID, runtimeName string) {
 	m.runtimeStateGauge.DeletePartialMatch(prometheus.Labels{
 		runtimeIDKeyName:   runtimeID,
 		runtimeNameKeyName: runtimeName,
@@ -111,6 +206,37 @@ func (m metricsImpl) IncRuntimeFSMStopCounter() {
 	m.runtimeFSMUnexpectedStopsCnt.Inc()
 }
 
+func (m metricsImpl) ObserveRuntimeFSMState(stateFnName, outcome string, duration time.Duration) {
+	m.runtimeFSMStateDuration.WithLabelValues(stateFnName, outcome).Observe(duration.Seconds())
+	m.runtimeFSMStateOutcomesCnt.WithLabelValues(stateFnName, outcome).Inc()
+}
+
+func (m metricsImpl) ObserveRuntimeTimeToReady(runtime v1.Runtime, duration time.Duration) {
+	m.runtimeTimeToReady.WithLabelValues(runtime.Spec.Shoot.Provider.Type).Observe(duration.Seconds())
+}
+
+func (m metricsImpl) IncRuntimeOperationTimeoutCounter(runtime v1.Runtime, operation string) {
+	m.runtimeOperationTimeoutsCnt.WithLabelValues(runtime.GetLabels()[RuntimeIDLabel], operation, runtime.Spec.Shoot.Provider.Type).Inc()
+}
+
+func (m metricsImpl) IncConfigReloadCounter(result string) {
+	m.configReloadsCnt.WithLabelValues(result).Inc()
+}
+
+func (m metricsImpl) SetRuntimeShootDrift(runtime v1.Runtime, driftedFields int) {
+	runtimeID := runtime.GetLabels()[RuntimeIDLabel]
+	if runtimeID != "" {
+		m.runtimeShootDriftGauge.WithLabelValues(runtimeID, runtime.Name, runtime.Spec.Shoot.Name).Set(float64(driftedFields))
+	}
+}
+
+func (m metricsImpl) SetRuntimeRemainingLifetime(runtime v1.Runtime, remaining time.Duration) {
+	runtimeID := runtime.GetLabels()[RuntimeIDLabel]
+	if runtimeID != "" {
+		m.runtimeRemainingLifetimeGauge.WithLabelValues(runtimeID, runtime.Name, runtime.Spec.Shoot.Name, runtime.Spec.Shoot.Provider.Type).Set(remaining.Seconds())
+	}
+}
+
 func (m metricsImpl) SetGardenerClusterStates(cluster v1.GardenerCluster) {
 	var runtimeID = cluster.GetLabels()[RuntimeIDLabel]
 	var shootName = cluster.GetLabels()[ShootNameLabel]
@@ -30,11 +30,31 @@ func (_m *Metrics) CleanUpRuntimeGauge(runtimeID string, runtimeName string) {
 	_m.Called(runtimeID, runtimeName)
 }
 
+// IncConfigReloadCounter provides a mock function with given fields: result
+func (_m *Metrics) IncConfigReloadCounter(result string) {
+	_m.Called(result)
+}
+
 // IncRuntimeFSMStopCounter provides a mock function with given fields:
 func (_m *Metrics) IncRuntimeFSMStopCounter() {
 	_m.Called()
 }
 
+// IncRuntimeOperationTimeoutCounter provides a mock function with given fields: runtime, operation
+func (_m *Metrics) IncRuntimeOperationTimeoutCounter(runtime v1.Runtime, operation string) {
+	_m.Called(runtime, operation)
+}
+
+// ObserveRuntimeFSMState provides a mock function with given fields: stateFnName, outcome, duration
+func (_m *Metrics) ObserveRuntimeFSMState(stateFnName string, outcome string, duration time.Duration) {
+	_m.Called(stateFnName, outcome, duration)
+}
+
+// ObserveRuntimeTimeToReady provides a mock function with given fields: runtime, duration
+func (_m *Metrics) ObserveRuntimeTimeToReady(runtime v1.Runtime, duration time.Duration) {
+	_m.Called(runtime, duration)
+}
+
 // ResetRuntimeMetrics provides a mock function with given fields:
 func (_m *Metrics) ResetRuntimeMetrics() {
 	_m.Called()
@@ -50,6 +70,16 @@ func (_m *Metrics) SetKubeconfigExpiration(secret corev1.Secret, rotationPeriod
 	_m.Called(secret, rotationPeriod, minimalRotationTimeRatio)
 }
 
+// SetRuntimeRemainingLifetime provides a mock function with given fields: runtime, remaining
+func (_m *Metrics) SetRuntimeRemainingLifetime(runtime v1.Runtime, remaining time.Duration) {
+	_m.Called(runtime, remaining)
+}
+
+// SetRuntimeShootDrift provides a mock function with given fields: runtime, driftedFields
+func (_m *Metrics) SetRuntimeShootDrift(runtime v1.Runtime, driftedFields int) {
+	_m.Called(runtime, driftedFields)
+}
+
 // SetRuntimeStates provides a mock function with given fields: runtime
 func (_m *Metrics) SetRuntimeStates(runtime v1.Runtime) {
 	_m.Called(runtime)
@@ -9,7 +9,12 @@ func getMaintenanceTimeWindow(s *systemState, m *fsm) *gardener.MaintenanceTimeW
 	var maintenanceWindowData *gardener.MaintenanceTimeWindow
 	if s.instance.Spec.Shoot.Purpose == "production" && m.ConverterConfig.MaintenanceWindow.WindowMapPath != "" {
 		var err error
-		maintenanceWindowData, err = maintenance.GetMaintenanceWindow(m.ConverterConfig.MaintenanceWindow.WindowMapPath, s.instance.Spec.Shoot.Region)
+		// the windows are loaded by the configuration watcher, the file is read directly only when the watcher is not used
+		if m.MaintenanceWindows != nil {
+			maintenanceWindowData, err = m.MaintenanceWindows.GetMaintenanceWindow(s.instance.Spec.Shoot.Region)
+		} else {
+			maintenanceWindowData, err = maintenance.GetMaintenanceWindow(m.ConverterConfig.MaintenanceWindow.WindowMapPath, s.instance.Spec.Shoot.Region)
+		}
 		if err != nil {
 			m.log.Error(err, "Failed to get Maintenance Window data for region")
 		}
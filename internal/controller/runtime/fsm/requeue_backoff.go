@@ -0,0 +1,117 @@
+package fsm
+
+import (
+	"math"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// RequeueBackoff counts how many times in a row a Runtime was requeued by the same state function
+// and stretches the requeue delays according to the configured backoff policies.
+// The attempts are kept in memory, so they start from zero after the controller restart.
+type RequeueBackoff struct {
+	config   config.BackoffConfig
+	random   func() float64
+	mu       sync.Mutex
+	attempts map[types.NamespacedName]requeueAttempts
+}
+
+type requeueAttempts struct {
+	stateFnName string
+	count       int
+}
+
+func NewRequeueBackoff(cfg config.BackoffConfig) *RequeueBackoff {
+	return &RequeueBackoff{
+		config:   cfg,
+		random:   rand.Float64,
+		attempts: map[types.NamespacedName]requeueAttempts{},
+	}
+}
+
+// Delay counts the requeue attempt of the Runtime and returns the delay computed from the policy of the state function.
+// The fixed delay is returned when there is no policy for the state function.
+func (b *RequeueBackoff) Delay(key types.NamespacedName, stateFnName string, fixedDelay time.Duration) time.Duration {
+	if b == nil {
+		return fixedDelay
+	}
+
+	b.mu.Lock()
+	attempts := b.attempts[key]
+	if attempts.stateFnName != stateFnName {
+		attempts = requeueAttempts{stateFnName: stateFnName}
+	}
+	attempt := attempts.count
+	attempts.count++
+	b.attempts[key] = attempts
+	policy, found := b.config.PolicyFor(stateFnName)
+	b.mu.Unlock()
+
+	if !found {
+		return fixedDelay
+	}
+
+	return b.computeDelay(policy, attempt, fixedDelay)
+}
+
+// SetConfig replaces the backoff policies, the counted attempts are kept
+func (b *RequeueBackoff) SetConfig(cfg config.BackoffConfig) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.config = cfg
+}
+
+// Attempts returns the number of consecutive requeues of the Runtime by the state function
+func (b *RequeueBackoff) Attempts(key types.NamespacedName, stateFnName string) int {
+	if b == nil {
+		return 0
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	attempts := b.attempts[key]
+	if attempts.stateFnName != stateFnName {
+		return 0
+	}
+	return attempts.count
+}
+
+// Reset forgets the requeue attempts of the Runtime
+func (b *RequeueBackoff) Reset(key types.NamespacedName) {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.attempts, key)
+}
+
+func (b *RequeueBackoff) computeDelay(policy config.BackoffPolicy, attempt int, fixedDelay time.Duration) time.Duration {
+	delay := float64(fixedDelay)
+	if policy.InitialDelay.Duration > 0 {
+		delay = float64(policy.InitialDelay.Duration)
+	}
+
+	delay *= math.Pow(math.Max(policy.Factor, 1), float64(attempt))
+
+	maxDelay := float64(policy.MaxDelay.Duration)
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+
+	delay += delay * policy.Jitter * b.random()
+
+	return time.Duration(delay)
+}
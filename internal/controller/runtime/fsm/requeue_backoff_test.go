@@ -0,0 +1,108 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var _ = Describe("KIM requeue backoff", func() {
+	runtimeKey := types.NamespacedName{Name: "test-runtime", Namespace: "kcp-system"}
+
+	newBackoff := func(cfg config.BackoffConfig) *RequeueBackoff {
+		backoff := NewRequeueBackoff(cfg)
+		backoff.random = func() float64 { return 1 }
+		return backoff
+	}
+
+	It("should use fixed delay when there is no policy for the state", func() {
+		// given
+		backoff := newBackoff(config.BackoffConfig{
+			States: map[string]config.BackoffPolicy{"sFnWaitForShootCreation": {Factor: 2}},
+		})
+
+		// when
+		first := backoff.Delay(runtimeKey, "sFnPatchExistingShoot", 15*time.Second)
+		second := backoff.Delay(runtimeKey, "sFnPatchExistingShoot", 15*time.Second)
+
+		// then
+		Expect(first).To(Equal(15 * time.Second))
+		Expect(second).To(Equal(15 * time.Second))
+		Expect(backoff.Attempts(runtimeKey, "sFnPatchExistingShoot")).To(Equal(2))
+	})
+
+	It("should grow delay exponentially up to max delay with jitter", func() {
+		// given
+		backoff := newBackoff(config.BackoffConfig{
+			Default: &config.BackoffPolicy{
+				InitialDelay: metav1.Duration{Duration: 10 * time.Second},
+				Factor:       2,
+				MaxDelay:     metav1.Duration{Duration: 30 * time.Second},
+				Jitter:       0.1,
+			},
+		})
+
+		// when
+		var delays []time.Duration
+		for i := 0; i < 4; i++ {
+			delays = append(delays, backoff.Delay(runtimeKey, "sFnWaitForShootCreation", time.Minute))
+		}
+
+		// then
+		Expect(delays).To(Equal([]time.Duration{11 * time.Second, 22 * time.Second, 33 * time.Second, 33 * time.Second}))
+	})
+
+	It("should start counting from zero when another state requeues or attempts are reset", func() {
+		// given
+		backoff := newBackoff(config.BackoffConfig{
+			Default: &config.BackoffPolicy{Factor: 2},
+		})
+		backoff.Delay(runtimeKey, "sFnWaitForShootCreation", 10*time.Second)
+		backoff.Delay(runtimeKey, "sFnWaitForShootCreation", 10*time.Second)
+
+		// when
+		afterStateChange := backoff.Delay(runtimeKey, "sFnWaitForShootReconcile", 10*time.Second)
+		backoff.Reset(runtimeKey)
+		afterReset := backoff.Delay(runtimeKey, "sFnWaitForShootReconcile", 10*time.Second)
+
+		// then
+		Expect(afterStateChange).To(Equal(10 * time.Second))
+		Expect(afterReset).To(Equal(10 * time.Second))
+		Expect(backoff.Attempts(runtimeKey, "sFnWaitForShootCreation")).To(Equal(0))
+	})
+
+	It("should apply backoff of the state requeueing the Runtime in fsm", func() {
+		// given
+		backoff := newBackoff(config.BackoffConfig{
+			States: map[string]config.BackoffPolicy{
+				"sFnMetricsTestRequeue": {Factor: 3},
+			},
+		})
+		rt := imv1.Runtime{ObjectMeta: metav1.ObjectMeta{Name: runtimeKey.Name, Namespace: runtimeKey.Namespace}}
+		testScheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(testScheme))
+
+		// when
+		var delays []time.Duration
+		for i := 0; i < 2; i++ {
+			fsm := must(newFakeFSM, withMockedMetrics(), withFakedK8sClient(testScheme))
+			fsm.RequeueBackoff = backoff
+			fsm.fn = sFnMetricsTestSwitch
+
+			result, err := fsm.Run(context.Background(), rt)
+			Expect(err).ToNot(HaveOccurred())
+			delays = append(delays, result.RequeueAfter)
+		}
+
+		// then
+		Expect(delays).To(Equal([]time.Duration{time.Minute, 3 * time.Minute}))
+	})
+})
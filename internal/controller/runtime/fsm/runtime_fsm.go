@@ -4,15 +4,21 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"regexp"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
 	"github.com/kyma-project/infrastructure-manager/pkg/config"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/maintenance"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,6 +27,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+//nolint:gochecknoglobals
+var closureSuffix = regexp.MustCompile(`(\.func[0-9]+|\.[0-9]+)+$`)
+
 type stateFn func(context.Context, *fsm, *systemState) (stateFn, *ctrl.Result, error)
 
 // runtime reconciler specific configuration
@@ -35,7 +44,13 @@ type RCCfg struct {
 	AuditLogMandatory             bool
 	Metrics                       metrics.Metrics
 	AuditLogging                  auditlogs.Configuration
+	MaintenanceWindows            maintenance.Windows
 	StructuredAuthEnabled         bool
+	RequeueBackoff                *RequeueBackoff
+	DriftRateLimiter              *rate.Limiter
+	RotationRateLimiter           *rate.Limiter
+	// PatchDryRun computes the patch plan for all runtimes instead of patching the shoots, the other shoot operations are not affected
+	PatchDryRun bool
 	config.Config
 }
 
@@ -48,6 +63,15 @@ func (f stateFn) name() string {
 	return name
 }
 
+// shortName strips the package path and the closure suffixes, e.g. "sFnUpdateStatus" is returned for "fsm.sFnUpdateStatus.func1" or inlined "fsm.sFnUpdateStatus.1"
+func shortName(fullName string) string {
+	name := closureSuffix.ReplaceAllString(fullName, "")
+	if i := strings.LastIndex(name, "."); i != -1 {
+		name = name[i+1:]
+	}
+	return name
+}
+
 type Watch = func(src source.Source, eventhandler handler.EventHandler, predicates ...predicate.Predicate) error
 
 type K8s struct {
@@ -71,6 +95,10 @@ func (m *fsm) Run(ctx context.Context, v imv1.Runtime) (ctrl.Result, error) {
 	state := systemState{instance: v}
 	var err error
 	var result *ctrl.Result
+	// the last state function requeueing the Runtime, status updates and events are not taken into account
+	var requeueOrigin string
+	// start time of the requeue origin, the status update and events are observed together with it
+	var originStartTime time.Time
 loop:
 	for {
 		select {
@@ -79,7 +107,20 @@ loop:
 			break loop
 		default:
 			stateFnName := m.fn.name()
-			m.fn, result, err = m.fn(ctx, m, &state)
+			stateCtx, span := tracing.Tracer().Start(ctx, shortName(stateFnName))
+			if !isStatusUpdateFn(stateFnName) {
+				requeueOrigin = shortName(stateFnName)
+				originStartTime = time.Now()
+			}
+			m.fn, result, err = m.fn(stateCtx, m, &state)
+			// the outcome of the requested status update is counted against the state function requesting it
+			if err != nil || m.fn == nil || !isStatusUpdateFn(m.fn.name()) {
+				m.Metrics.ObserveRuntimeFSMState(requeueOrigin, stateOutcome(m.fn, result, err), time.Since(originStartTime))
+			}
+			tracing.EndSpan(span, err)
+			if !isStatusUpdateFn(stateFnName) {
+				state.visitState(shortName(stateFnName))
+			}
 			newStateFnName := m.fn.name()
 			m.log.V(log_level.TRACE).WithValues("result", result, "err", err, "mFnIsNill", m.fn == nil).Info(fmt.Sprintf("switching state from %s to %s", stateFnName, newStateFnName))
 			if m.fn == nil || err != nil {
@@ -92,6 +133,31 @@ loop:
 		WithValues("result", result).
 		Info("Reconciliation done")
 
+	if !state.transitionSaved {
+		m.saveTransitionOutcome(ctx, &state, result, err)
+	}
+
+	runtimeKey := types.NamespacedName{Name: v.Name, Namespace: v.Namespace}
+	// periodic checks are requeued with their own interval
+	if err == nil && result != nil && result.RequeueAfter > 0 && !isPeriodicFn(requeueOrigin) {
+		result.RequeueAfter = m.RequeueBackoff.Delay(runtimeKey, requeueOrigin, result.RequeueAfter)
+		m.log.V(log_level.DEBUG).Info("Requeue delay computed",
+			"state", requeueOrigin,
+			"attempts", m.RequeueBackoff.Attempts(runtimeKey, requeueOrigin),
+			"requeueAfter", result.RequeueAfter)
+	} else {
+		m.RequeueBackoff.Reset(runtimeKey)
+	}
+
+	if err == nil {
+		// the expiring runtimes are checked again at the latest at the next warning lead time
+		result = requeueNoLaterThan(result, nextExpirationCheck(m.Expiration, state.instance))
+		// the Ready runtimes are checked again for the started and scheduled credentials rotations
+		result = requeueNoLaterThan(result, nextCredentialsRotationCheck(m.CredentialsRotation, &state))
+		// the access of the temporary administrators is revoked when it expires
+		result = requeueNoLaterThan(result, nextTemporaryAdministratorExpiry(state.instance))
+	}
+
 	if result != nil {
 		return *result, err
 	}
@@ -101,6 +167,67 @@ loop:
 	}, err
 }
 
+// requeueNoLaterThan shortens the requeue delay of the result to the given duration, the zero duration leaves the result unchanged
+func requeueNoLaterThan(result *ctrl.Result, next time.Duration) *ctrl.Result {
+	if next <= 0 {
+		return result
+	}
+
+	if result == nil || (!result.Requeue && (result.RequeueAfter == 0 || result.RequeueAfter > next)) {
+		return &ctrl.Result{RequeueAfter: next}
+	}
+
+	return result
+}
+
+const (
+	outcomeSwitch  = "switch"
+	outcomeRequeue = "requeue"
+	outcomeStop    = "stop"
+	outcomeError   = "error"
+)
+
+func stateOutcome(next stateFn, result *ctrl.Result, err error) string {
+	switch {
+	case err != nil:
+		return outcomeError
+	case next != nil:
+		return outcomeSwitch
+	case result != nil && (result.Requeue || result.RequeueAfter > 0):
+		return outcomeRequeue
+	}
+
+	return outcomeStop
+}
+
+func isStatusUpdateFn(stateFnName string) bool {
+	name := shortName(stateFnName)
+	return name == "sFnUpdateStatus" || name == "sFnEmmitEventfunc"
+}
+
+func isPeriodicFn(stateFnName string) bool {
+	return shortName(stateFnName) == "sFnDetectDrift"
+}
+
+// saveTransitionOutcome stores the outcome of the reconciliation which didn't update the status,
+// the outcome repeating the last stored one is not stored again to avoid the status updates on every requeue
+func (m *fsm) saveTransitionOutcome(ctx context.Context, s *systemState, result *ctrl.Result, err error) {
+	transition := s.newTransition(result, err)
+	history := s.snapshot.TransitionHistory
+	if len(s.visitedStates) == 0 || (len(history) > 0 && isSameOutcome(history[len(history)-1], transition)) {
+		return
+	}
+
+	// only the history is stored, other status changes of the reconciliation are not meant to be persisted
+	runtime := s.instance.DeepCopy()
+	runtime.Status = *s.snapshot.DeepCopy()
+	runtime.Status.TransitionHistory = appendTransition(runtime.Status.TransitionHistory, transition)
+
+	if updateErr := m.Status().Update(ctx, runtime); updateErr != nil {
+		m.log.V(log_level.DEBUG).Info("Failed to store the reconciliation outcome", "error", updateErr)
+	}
+}
+
 func NewFsm(log logr.Logger, cfg RCCfg, k8s K8s) Fsm {
 	return &fsm{
 		fn:    sFnTakeSnapshot,
@@ -0,0 +1,83 @@
+package fsm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/diff"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// sFnAdoptShoot takes over the existing shoot created outside of KIM. The changes the first patch would introduce
+// are reported in the ShootAdopted condition, and the shoot is patched only after they are acknowledged with the annotation.
+func sFnAdoptShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	if s.shoot == nil {
+		m.log.Info("Shoot to adopt does not exist", "RuntimeCR", s.instance.Name, "shoot", s.instance.Spec.Shoot.Name)
+		setAdoptionCondition(&s.instance, metav1.ConditionFalse, imv1.ConditionReasonShootToAdoptNotFound,
+			fmt.Sprintf("Shoot %s to adopt does not exist, remove the %s annotation to create a new one", s.instance.Spec.Shoot.Name, reconciler.AdoptAnnotation))
+		return updateStatusAndStop()
+	}
+
+	desiredShoot, err := convertForComparison(ctx, m, s)
+	if err != nil {
+		m.log.Error(err, "Failed to convert Runtime for shoot adoption", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return updateStatePendingWithErrorAndStop(&s.instance, imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonConversionError, fmt.Sprintf("Runtime conversion error %v", err))
+	}
+
+	changes, err := diff.ShootSpec(desiredShoot, *s.shoot)
+	if err != nil {
+		m.log.Error(err, "Failed to compare shoot for adoption, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	hash := changesHash(changes)
+	if len(changes) > 0 && s.instance.Annotations[reconciler.AdoptAcknowledgeAnnotation] != hash {
+		for _, change := range changes {
+			m.log.Info("Shoot adoption change", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "change", change.String())
+		}
+
+		msg := fmt.Sprintf("Adopting the shoot changes %d field(s): %s. Set the %s annotation to %s to adopt the shoot",
+			len(changes), changedPaths(changes), reconciler.AdoptAcknowledgeAnnotation, hash)
+		s.instance.UpdateStatePending(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonAdoptionPendingAcknowledgement, "Unknown", "Waiting for acknowledgement of the shoot adoption")
+		setAdoptionCondition(&s.instance, metav1.ConditionFalse, imv1.ConditionReasonAdoptionPendingAcknowledgement, msg)
+		return updateStatusAndStop()
+	}
+
+	m.log.Info("Adopting shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "changes", len(changes))
+	annotations := s.instance.Annotations
+	delete(annotations, reconciler.AdoptAnnotation)
+	delete(annotations, reconciler.AdoptAcknowledgeAnnotation)
+	s.instance.SetAnnotations(annotations)
+
+	if err := m.Update(ctx, &s.instance); err != nil {
+		m.log.Error(err, "Failed to remove adoption annotations, scheduling for retry", "RuntimeCR", s.instance.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	setAdoptionCondition(&s.instance, metav1.ConditionTrue, imv1.ConditionReasonShootAdopted, fmt.Sprintf("Shoot adopted with %d changed field(s)", len(changes)))
+	return switchState(sFnPatchExistingShoot)
+}
+
+func setAdoptionCondition(instance *imv1.Runtime, status metav1.ConditionStatus, reason imv1.RuntimeConditionReason, msg string) {
+	meta.SetStatusCondition(&instance.Status.Conditions, metav1.Condition{
+		Type:    string(imv1.ConditionTypeShootAdopted),
+		Status:  status,
+		Reason:  string(reason),
+		Message: msg,
+	})
+}
+
+// changesHash identifies the set of changes, so the acknowledgement is invalidated when the Runtime or the shoot changes
+func changesHash(changes []diff.Change) string {
+	h := sha256.New()
+	for _, change := range changes {
+		_, _ = fmt.Fprintln(h, change.String())
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
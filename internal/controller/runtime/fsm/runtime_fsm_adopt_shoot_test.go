@@ -0,0 +1,118 @@
+package fsm
+
+import (
+	"context"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/diff"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM sFnAdoptShoot", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	setupAdoptTest := func(annotations map[string]string) (*fsm, *systemState) {
+		runtime := makeInputRuntimeWithAnnotation(annotations)
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+
+		s := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+		existingShoot, err := convertForComparison(context.Background(), fsm, s)
+		Expect(err).ToNot(HaveOccurred())
+		s.shoot = &existingShoot
+
+		return fsm, s
+	}
+
+	adoptionCondition := func(s *systemState) *metav1.Condition {
+		return meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeShootAdopted))
+	}
+
+	It("should switch to sFnAdoptShoot for Runtime with the adopt annotation", func() {
+		// given
+		fsm, s := setupAdoptTest(map[string]string{reconciler.AdoptAnnotation: "true"})
+		s.instance.Finalizers = []string{fsm.Finalizer}
+
+		// when
+		next, _, _ := sFnInitialize(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnAdoptShoot"))
+	})
+
+	It("should report missing shoot", func() {
+		// given
+		fsm, s := setupAdoptTest(map[string]string{reconciler.AdoptAnnotation: "true"})
+		s.shoot = nil
+
+		// when
+		next, _, _ := sFnAdoptShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(adoptionCondition(s).Reason).To(Equal(string(imv1.ConditionReasonShootToAdoptNotFound)))
+	})
+
+	It("should adopt shoot without changes", func() {
+		// given
+		ctx := context.Background()
+		fsm, s := setupAdoptTest(map[string]string{reconciler.AdoptAnnotation: "true"})
+
+		// when
+		next, _, _ := sFnAdoptShoot(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnPatchExistingShoot"))
+		Expect(adoptionCondition(s).Status).To(Equal(metav1.ConditionTrue))
+
+		var runtime imv1.Runtime
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(&s.instance), &runtime)).To(Succeed())
+		Expect(runtime.Annotations).ToNot(HaveKey(reconciler.AdoptAnnotation))
+	})
+
+	It("should wait for acknowledgement of the changes", func() {
+		// given
+		fsm, s := setupAdoptTest(map[string]string{reconciler.AdoptAnnotation: "true"})
+		s.shoot.Spec.Provider.Workers[0].Maximum = 10
+
+		// when
+		next, _, _ := sFnAdoptShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(adoptionCondition(s).Status).To(Equal(metav1.ConditionFalse))
+		Expect(adoptionCondition(s).Reason).To(Equal(string(imv1.ConditionReasonAdoptionPendingAcknowledgement)))
+		Expect(adoptionCondition(s).Message).To(ContainSubstring("spec.provider.workers[0].maximum"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+	})
+
+	It("should adopt shoot when the changes are acknowledged", func() {
+		// given
+		fsm, s := setupAdoptTest(map[string]string{reconciler.AdoptAnnotation: "true"})
+		desiredShoot := *s.shoot.DeepCopy()
+		s.shoot.Spec.Provider.Workers[0].Maximum = 10
+		changes, err := diff.ShootSpec(desiredShoot, *s.shoot)
+		Expect(err).ToNot(HaveOccurred())
+		s.instance.Annotations[reconciler.AdoptAcknowledgeAnnotation] = changesHash(changes)
+		Expect(fsm.Update(context.Background(), &s.instance)).To(Succeed())
+
+		// when
+		next, _, _ := sFnAdoptShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnPatchExistingShoot"))
+		Expect(s.instance.Annotations).ToNot(HaveKey(reconciler.AdoptAcknowledgeAnnotation))
+	})
+})
@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"slices"
+	"time"
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +26,9 @@ var (
 	}
 )
 
+// accessBindingLabel marks the cluster role bindings created for the access bindings
+const accessBindingLabel = "operator.kyma-project.io/access-binding"
+
 func sFnApplyClusterRoleBindings(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
 	shootAdminClient, err := GetShootClient(ctx, m.Client, s.instance)
 	if err != nil {
@@ -38,12 +43,17 @@ func sFnApplyClusterRoleBindings(ctx context.Context, m *fsm, s *systemState) (s
 		return requeue()
 	}
 
-	removed := getRemoved(crbList.Items, s.instance.Spec.Security.Administrators)
-	missing := getMissing(crbList.Items, s.instance.Spec.Security.Administrators)
+	desired := getDesiredClusterRoleBindings(s.instance.Spec.Security)
+	removed := getRemoved(crbList.Items, desired)
+	missing := getMissing(crbList.Items, desired)
+	checkTime := time.Now()
+	revoked, granted := getTemporaryAdministratorChanges(crbList.Items, s.instance.Spec.Security.TemporaryAdministrators, checkTime)
 
 	for _, fn := range []func() error{
 		newDelCRBs(ctx, shootAdminClient, removed),
 		newAddCRBs(ctx, shootAdminClient, missing),
+		newDelCRBs(ctx, shootAdminClient, revoked),
+		newAddCRBs(ctx, shootAdminClient, granted),
 	} {
 		if err := fn(); err != nil {
 			updateCRBApplyFailed(&s.instance)
@@ -53,6 +63,12 @@ func sFnApplyClusterRoleBindings(ctx context.Context, m *fsm, s *systemState) (s
 		logDeletedClusterRoleBindings(removed, m, s)
 	}
 
+	recordTemporaryAdministratorChanges(m, s, revoked, granted)
+	s.instance.Status.TemporaryAdministratorsCheckTime = nil
+	if len(s.instance.Spec.Security.TemporaryAdministrators) > 0 {
+		s.instance.Status.TemporaryAdministratorsCheckTime = &metav1.Time{Time: checkTime}
+	}
+
 	s.instance.UpdateStateReady(
 		imv1.ConditionTypeRuntimeConfigured,
 		imv1.ConditionReasonAdministratorsConfigured,
@@ -97,7 +113,7 @@ var GetShootClient = func(ctx context.Context, cnt client.Client, runtime imv1.R
 		return nil, err
 	}
 
-	return shootClientWithAdmin, nil
+	return tracing.NewClient(shootClientWithAdmin, "skr"), nil
 }
 
 func getKubeconfigSecret(ctx context.Context, cnt client.Client, runtimeID, namespace string) (corev1.Secret, error) {
@@ -118,42 +134,45 @@ func getKubeconfigSecret(ctx context.Context, cnt client.Client, runtimeID, name
 	return kubeconfigSecret, nil
 }
 
-func isRBACUserKind() func(rbacv1.Subject) bool {
-	return func(s rbacv1.Subject) bool {
-		return s.Kind == rbacv1.UserKind
+// getDesiredClusterRoleBindings returns the cluster role bindings of the administrators and access bindings
+func getDesiredClusterRoleBindings(security imv1.Security) (desired []rbacv1.ClusterRoleBinding) {
+	for _, admin := range security.Administrators {
+		desired = append(desired, toAdminClusterRoleBinding(admin))
 	}
-}
 
-func isRBACUserKindOneOf(names []string) func(rbacv1.Subject) bool {
-	return func(s rbacv1.Subject) bool {
-		return slices.Contains(names, s.Name)
+	for _, binding := range security.AccessBindings {
+		desired = append(desired, toAccessClusterRoleBinding(binding))
 	}
+
+	return desired
 }
 
-func getRemoved(crbs []rbacv1.ClusterRoleBinding, admins []string) (removed []rbacv1.ClusterRoleBinding) {
-	// iterate over cluster role bindings to find out removed administrators
+func getRemoved(crbs []rbacv1.ClusterRoleBinding, desired []rbacv1.ClusterRoleBinding) (removed []rbacv1.ClusterRoleBinding) {
+	// iterate over cluster role bindings to find out removed subjects
 	for _, crb := range crbs {
 		if !managedByKIM(crb) {
 			// cluster role binding is not controlled by KIM
 			continue
 		}
 
-		if crb.RoleRef.Kind != "ClusterRole" && crb.RoleRef.Name != "cluster-admin" {
-			// cluster role binding is not admin
+		if isTemporaryAdministratorBinding(crb) {
+			// temporary administrators are revoked after their access expires
 			continue
 		}
 
-		if !slices.ContainsFunc(crb.Subjects, isRBACUserKind()) {
-			// cluster role binding is not user kind
+		if !isAccessBinding(crb) && !isAdministratorBinding(crb) {
+			// only the bindings created by KIM are removed, other bindings with the label are kept
 			continue
 		}
 
-		if slices.ContainsFunc(crb.Subjects, isRBACUserKindOneOf(admins)) {
-			// the administrator was not removed
+		if slices.ContainsFunc(desired, func(binding rbacv1.ClusterRoleBinding) bool {
+			return newContainsAccess(binding)(crb)
+		}) {
+			// the subject still has access to the cluster role
 			continue
 		}
 
-		// administrator was removed
+		// subject was removed
 		removed = append(removed, crb)
 	}
 
@@ -166,30 +185,95 @@ func managedByKIM(crb rbacv1.ClusterRoleBinding) bool {
 	return isManagedByKIM
 }
 
+func isAccessBinding(crb rbacv1.ClusterRoleBinding) bool {
+	return managedByKIM(crb) && crb.Labels[accessBindingLabel] == "true"
+}
+
+// isAdministratorBinding matches the cluster role bindings of the administrators, which bind the cluster-admin role to users only
+func isAdministratorBinding(crb rbacv1.ClusterRoleBinding) bool {
+	if crb.RoleRef.Kind != "ClusterRole" || crb.RoleRef.Name != "cluster-admin" || len(crb.Subjects) == 0 {
+		return false
+	}
+
+	return !slices.ContainsFunc(crb.Subjects, func(subject rbacv1.Subject) bool {
+		return subject.Kind != rbacv1.UserKind
+	})
+}
+
+// newContainsAccess matches the cluster role bindings managed by KIM, which bind the same cluster role to the subject of the desired binding
+//
 //nolint:gochecknoglobals
-var newContainsAdmin = func(admin string) func(rbacv1.ClusterRoleBinding) bool {
+var newContainsAccess = func(desired rbacv1.ClusterRoleBinding) func(rbacv1.ClusterRoleBinding) bool {
 	return func(crb rbacv1.ClusterRoleBinding) bool {
-		if !managedByKIM(crb) {
+		if !managedByKIM(crb) || isTemporaryAdministratorBinding(crb) {
+			return false
+		}
+
+		if crb.RoleRef.Kind != desired.RoleRef.Kind || crb.RoleRef.Name != desired.RoleRef.Name {
 			return false
 		}
-		isAdmin := isRBACUserKindOneOf([]string{admin})
-		return slices.ContainsFunc(crb.Subjects, isAdmin)
+
+		for _, subject := range desired.Subjects {
+			if !slices.ContainsFunc(crb.Subjects, isSameSubject(subject)) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+func isSameSubject(subject rbacv1.Subject) func(rbacv1.Subject) bool {
+	return func(s rbacv1.Subject) bool {
+		return s.Kind == subject.Kind && s.Name == subject.Name && s.Namespace == subject.Namespace
 	}
 }
 
-func getMissing(crbs []rbacv1.ClusterRoleBinding, admins []string) (missing []rbacv1.ClusterRoleBinding) {
-	for _, admin := range admins {
-		containsAdmin := newContainsAdmin(admin)
-		if slices.ContainsFunc(crbs, containsAdmin) {
+func getMissing(crbs []rbacv1.ClusterRoleBinding, desired []rbacv1.ClusterRoleBinding) (missing []rbacv1.ClusterRoleBinding) {
+	for _, crb := range desired {
+		containsAccess := newContainsAccess(crb)
+		if slices.ContainsFunc(crbs, containsAccess) || slices.ContainsFunc(missing, containsAccess) {
 			continue
 		}
-		crb := toAdminClusterRoleBinding(admin)
 		missing = append(missing, crb)
 	}
 
 	return missing
 }
 
+func toAccessClusterRoleBinding(binding imv1.AccessBinding) rbacv1.ClusterRoleBinding {
+	subject := rbacv1.Subject{
+		Kind:     binding.Kind,
+		Name:     binding.Name,
+		APIGroup: rbacv1.GroupName,
+	}
+
+	switch subject.Kind {
+	case rbacv1.ServiceAccountKind:
+		subject.APIGroup = ""
+		subject.Namespace = binding.Namespace
+	case "":
+		subject.Kind = rbacv1.UserKind
+	}
+
+	labels := map[string]string{accessBindingLabel: "true"}
+	for key, value := range labelsManagedByKIM {
+		labels[key] = value
+	}
+
+	return rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "access-",
+			Labels:       labels,
+		},
+		Subjects: []rbacv1.Subject{subject},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     binding.ClusterRole,
+		},
+	}
+}
+
 func toAdminClusterRoleBindingWithLabel(name string, key, value string) rbacv1.ClusterRoleBinding {
 	// initialize labels
 	labels := map[string]string{}
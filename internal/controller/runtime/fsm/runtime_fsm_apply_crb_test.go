@@ -21,17 +21,22 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 
 	var testErr = fmt.Errorf("test error")
 
+	groupViewer := imv1.AccessBinding{Kind: rbacv1.GroupKind, Name: "viewers", ClusterRole: "view"}
+	serviceAccountEditor := imv1.AccessBinding{Kind: rbacv1.ServiceAccountKind, Name: "deployer", Namespace: "cicd", ClusterRole: "edit"}
+
 	withMockedMetrics := func() fakeFSMOpt {
 		m := &mocks.Metrics{}
 		m.On("SetRuntimeStates", mock.Anything).Return()
 		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 		m.On("IncRuntimeFSMStopCounter").Return()
+		m.On("ObserveRuntimeFSMState", mock.Anything, mock.Anything, mock.Anything).Return()
+		m.On("ObserveRuntimeTimeToReady", mock.Anything, mock.Anything).Return()
 		return withMetrics(m)
 	}
 
 	DescribeTable("getMissing",
 		func(tc tcCRBData) {
-			actual := getMissing(tc.crbs, tc.admins)
+			actual := getMissing(tc.crbs, getDesiredClusterRoleBindings(imv1.Security{Administrators: tc.admins, AccessBindings: tc.bindings}))
 			Expect(actual).To(BeComparableTo(tc.expected))
 		},
 		Entry("should return a list with CRBs to be created", tcCRBData{
@@ -58,11 +63,37 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 			},
 			expected: nil,
 		}),
+		Entry("should return a list with group and service account CRBs to be created", tcCRBData{
+			bindings: []imv1.AccessBinding{groupViewer, serviceAccountEditor},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAccessClusterRoleBinding(imv1.AccessBinding{Kind: rbacv1.GroupKind, Name: "viewers", ClusterRole: "edit"}),
+			},
+			expected: []rbacv1.ClusterRoleBinding{
+				toAccessClusterRoleBinding(groupViewer),
+				toAccessClusterRoleBinding(serviceAccountEditor),
+			},
+		}),
+		Entry("should not return CRB for admin also listed in access bindings", tcCRBData{
+			admins:   []string{"test1"},
+			bindings: []imv1.AccessBinding{{Name: "test1", ClusterRole: "cluster-admin"}},
+			crbs:     nil,
+			expected: []rbacv1.ClusterRoleBinding{
+				toAdminClusterRoleBinding("test1"),
+			},
+		}),
+		Entry("should return nil list if no access bindings missing", tcCRBData{
+			bindings: []imv1.AccessBinding{groupViewer, serviceAccountEditor},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAccessClusterRoleBinding(groupViewer),
+				toAccessClusterRoleBinding(serviceAccountEditor),
+			},
+			expected: nil,
+		}),
 	)
 
 	DescribeTable("getRemoved",
 		func(tc tcCRBData) {
-			actual := getRemoved(tc.crbs, tc.admins)
+			actual := getRemoved(tc.crbs, getDesiredClusterRoleBindings(imv1.Security{Administrators: tc.admins, AccessBindings: tc.bindings}))
 			Expect(actual).To(BeComparableTo(tc.expected))
 		},
 		Entry("should return nil list if CRB list is nil", tcCRBData{
@@ -152,6 +183,35 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 			},
 			expected: nil,
 		}),
+		Entry("should remove group and service account CRBs not in the access bindings", tcCRBData{
+			admins:   []string{"test1"},
+			bindings: []imv1.AccessBinding{groupViewer},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAdminClusterRoleBinding("test1"),
+				toAccessClusterRoleBinding(groupViewer),
+				toAccessClusterRoleBinding(serviceAccountEditor),
+			},
+			expected: []rbacv1.ClusterRoleBinding{
+				toAccessClusterRoleBinding(serviceAccountEditor),
+			},
+		}),
+		Entry("should not remove labelled group CRB not created for the access bindings", tcCRBData{
+			bindings: []imv1.AccessBinding{groupViewer},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toLegacyGroupClusterRoleBinding("legacy-viewers"),
+				toAccessClusterRoleBinding(groupViewer),
+			},
+			expected: nil,
+		}),
+		Entry("should remove CRB with changed cluster role", tcCRBData{
+			bindings: []imv1.AccessBinding{groupViewer},
+			crbs: []rbacv1.ClusterRoleBinding{
+				toAccessClusterRoleBinding(imv1.AccessBinding{Kind: rbacv1.GroupKind, Name: "viewers", ClusterRole: "edit"}),
+			},
+			expected: []rbacv1.ClusterRoleBinding{
+				toAccessClusterRoleBinding(imv1.AccessBinding{Kind: rbacv1.GroupKind, Name: "viewers", ClusterRole: "edit"}),
+			},
+		}),
 	)
 
 	testRuntime := imv1.Runtime{
@@ -270,6 +330,7 @@ var _ = Describe(`runtime_fsm_apply_crb`, Label("applyCRB"), func() {
 type tcCRBData struct {
 	crbs     []rbacv1.ClusterRoleBinding
 	admins   []string
+	bindings []imv1.AccessBinding
 	expected []rbacv1.ClusterRoleBinding
 }
 
@@ -311,6 +372,25 @@ func toManagedClusterRoleBinding(name, managedBy string) rbacv1.ClusterRoleBindi
 		"reconciler.kyma-project.io/managed-by", managedBy)
 }
 
+func toLegacyGroupClusterRoleBinding(name string) rbacv1.ClusterRoleBinding {
+	return rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"reconciler.kyma-project.io/managed-by": "infrastructure-manager"},
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:     rbacv1.GroupKind,
+			Name:     name,
+			APIGroup: rbacv1.GroupName,
+		}},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "view",
+		},
+	}
+}
+
 func toServiceAccountClusterRoleBinding(name string) rbacv1.ClusterRoleBinding {
 	return rbacv1.ClusterRoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
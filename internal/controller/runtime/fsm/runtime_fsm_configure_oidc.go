@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/extensions"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -28,7 +29,7 @@ func sFnConfigureOidc(ctx context.Context, m *fsm, s *systemState) (stateFn, *ct
 		return switchState(sFnApplyClusterRoleBindings)
 	}
 
-	defaultAdditionalOidcIfNotPresent(&s.instance, m.RCCfg)
+	extender.DefaultAdditionalOidcIfNotPresent(&s.instance, m.ClusterConfig.DefaultSharedIASTenant)
 	err := recreateOpenIDConnectResources(ctx, m, s)
 
 	if err != nil {
@@ -48,31 +49,6 @@ func sFnConfigureOidc(ctx context.Context, m *fsm, s *systemState) (stateFn, *ct
 	return switchState(sFnApplyClusterRoleBindings)
 }
 
-func defaultAdditionalOidcIfNotPresent(runtime *imv1.Runtime, cfg RCCfg) {
-	additionalOidcConfig := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig
-
-	additionalOIDCConfigEmpty := func() bool {
-		if additionalOidcConfig == nil {
-			return true
-		}
-
-		for _, oidcConfig := range *additionalOidcConfig {
-			if oidcConfig.ClientID != nil && oidcConfig.IssuerURL != nil {
-				return false
-			}
-		}
-
-		return true
-	}
-
-	if additionalOIDCConfigEmpty() {
-		additionalOidcConfig = &[]imv1.OIDCConfig{}
-		defaultOIDCConfig := cfg.ClusterConfig.DefaultSharedIASTenant.ToOIDCConfig()
-		*additionalOidcConfig = append(*additionalOidcConfig, imv1.OIDCConfig{OIDCConfig: defaultOIDCConfig})
-		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = additionalOidcConfig
-	}
-}
-
 func recreateOpenIDConnectResources(ctx context.Context, m *fsm, s *systemState) error {
 	shootAdminClient, shootClientError := GetShootClient(ctx, m.Client, s.instance)
 	if shootClientError != nil {
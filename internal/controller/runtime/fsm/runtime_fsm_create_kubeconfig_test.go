@@ -41,6 +41,8 @@ var _ = Describe("KIM sFnCreateKubeconfig", func() {
 		m.On("SetRuntimeStates", mock.Anything).Return()
 		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 		m.On("IncRuntimeFSMStopCounter").Return()
+		m.On("ObserveRuntimeFSMState", mock.Anything, mock.Anything, mock.Anything).Return()
+		m.On("ObserveRuntimeTimeToReady", mock.Anything, mock.Anything).Return()
 		return withMetrics(m)
 	}
 
@@ -6,6 +6,7 @@ import (
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -29,11 +30,16 @@ func sFnDeleteKubeconfig(ctx context.Context, m *fsm, s *systemState) (stateFn,
 		}
 
 		// out section
+		next := sFnDeleteShoot
+		if reconciler.ShouldOrphanShoot(s.instance.Annotations) {
+			next = sFnOrphanShoot
+		}
+
 		return ensureTerminatingStatusConditionAndContinue(&s.instance,
 			imv1.ConditionTypeRuntimeDeprovisioned,
 			imv1.ConditionReasonGardenerCRDeleted,
 			"Gardener Cluster CR successfully deleted",
-			sFnDeleteShoot)
+			next)
 	}
 
 	// wait section
@@ -15,6 +15,9 @@ func sFnDeleteShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl
 	// wait section
 	if !s.shoot.GetDeletionTimestamp().IsZero() {
 		m.log.V(log_level.DEBUG).Info("Waiting for shoot to be deleted", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+		if setTimeoutConditionIfExceeded(m, s, shootOperationDelete) {
+			return updateStatusAndRequeueAfter(m.RequeueDurationShootDelete)
+		}
 		return requeueAfter(m.RequeueDurationShootDelete)
 	}
 
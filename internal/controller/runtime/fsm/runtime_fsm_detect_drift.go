@@ -0,0 +1,149 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/diff"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/kyma-project/kim-snatch/api/v1beta1"
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	defaultDriftCheckInterval  = time.Hour
+	driftCheckRateLimitedDelay = time.Minute
+	maxChangedPathsInMessage   = 5
+)
+
+// sFnDetectDrift compares the shoot with the result of the Runtime conversion, it is executed periodically for Ready and Failed runtimes
+func sFnDetectDrift(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	interval := m.DriftDetection.Interval.Duration
+	if interval <= 0 {
+		interval = defaultDriftCheckInterval
+	}
+
+	if lastCheck := s.instance.Status.LastDriftCheckTime; lastCheck != nil {
+		if remaining := time.Until(lastCheck.Add(interval)); remaining > 0 {
+			return updateStatusAndRequeueAfter(withJitter(remaining, m.DriftDetection.Jitter))
+		}
+	}
+
+	if m.DriftRateLimiter != nil && !m.DriftRateLimiter.Allow() {
+		m.log.V(log_level.DEBUG).Info("Drift check rate limit exceeded, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return updateStatusAndRequeueAfter(withJitter(driftCheckRateLimitedDelay, 1))
+	}
+
+	desiredShoot, err := convertForComparison(ctx, m, s)
+	if err != nil {
+		m.log.Error(err, "Failed to convert Runtime for drift detection, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return updateStatusAndRequeueAfter(withJitter(interval, m.DriftDetection.Jitter))
+	}
+
+	changes, err := diff.ShootSpec(desiredShoot, *s.shoot)
+	if err != nil {
+		m.log.Error(err, "Failed to compare shoot for drift detection, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return updateStatusAndRequeueAfter(withJitter(interval, m.DriftDetection.Jitter))
+	}
+
+	s.instance.Status.LastDriftCheckTime = &metav1.Time{Time: time.Now()}
+	m.Metrics.SetRuntimeShootDrift(s.instance, len(changes))
+
+	if len(changes) == 0 {
+		meta.SetStatusCondition(&s.instance.Status.Conditions, metav1.Condition{
+			Type:    string(imv1.ConditionTypeDriftDetected),
+			Status:  metav1.ConditionFalse,
+			Reason:  string(imv1.ConditionReasonShootInSync),
+			Message: "Shoot matches the Runtime spec",
+		})
+		return updateStatusAndRequeueAfter(withJitter(interval, m.DriftDetection.Jitter))
+	}
+
+	msg := driftMessage(changes)
+	m.log.Info(msg, "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	meta.SetStatusCondition(&s.instance.Status.Conditions, metav1.Condition{
+		Type:    string(imv1.ConditionTypeDriftDetected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(imv1.ConditionReasonShootDriftDetected),
+		Message: msg,
+	})
+
+	if m.DriftDetection.Remediate && !reconciler.ShouldSuspendReconciliation(s.instance.Annotations) && !isDryRun(m, s) {
+		m.log.Info("Remediating shoot drift", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return switchState(sFnPatchExistingShoot)
+	}
+
+	return updateStatusAndRequeueAfter(withJitter(interval, m.DriftDetection.Jitter))
+}
+
+// convertForComparison converts the Runtime the same way as for the shoot patch, so the result can be compared with the existing shoot
+func convertForComparison(ctx context.Context, m *fsm, s *systemState) (shoot gardener.Shoot, err error) {
+	data, err := m.AuditLogging.GetAuditLogData(s.instance.Spec.Shoot.Provider.Type, s.instance.Spec.Shoot.Region)
+	if err != nil {
+		if m.AuditLogMandatory {
+			return shoot, errors.Wrap(err, msgFailedToConfigureAuditlogs)
+		}
+		data = auditlogs.AuditLogData{}
+	}
+
+	var registrycache []v1beta1.RegistryCache
+	if s.instance.Spec.Caching != nil && s.instance.Spec.Caching.Enabled {
+		registrycache, err = getRegistryCache(ctx, m.Client, s.instance)
+		if err != nil {
+			return shoot, errors.Wrap(err, msgFailedToConfigureRegistryCache)
+		}
+	}
+
+	return convertPatch(&s.instance, patchOpts(m, s, data, registrycache))
+}
+
+func driftMessage(changes []diff.Change) string {
+	return fmt.Sprintf("Shoot differs from the Runtime spec in %d field(s): %s", len(changes), changedPaths(changes))
+}
+
+// changedPaths lists the paths of the first changes to keep the condition messages short
+func changedPaths(changes []diff.Change) string {
+	paths := diff.Paths(changes)
+	if len(paths) > maxChangedPathsInMessage {
+		paths = append(paths[:maxChangedPathsInMessage], "...")
+	}
+	return strings.Join(paths, ", ")
+}
+
+func withJitter(delay time.Duration, jitter float64) time.Duration {
+	return delay + time.Duration(float64(delay)*jitter*rand.Float64())
+}
+
+// NewDriftRateLimiter creates the limiter shared by the drift checks of all runtimes
+func NewDriftRateLimiter(cfg config.DriftDetection) *rate.Limiter {
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	SetDriftRateLimit(limiter, cfg)
+	return limiter
+}
+
+// SetDriftRateLimit applies the configured number of checks per minute, the checks are not limited when it is not set
+func SetDriftRateLimit(limiter *rate.Limiter, cfg config.DriftDetection) {
+	setPerMinuteLimit(limiter, cfg.ChecksPerMinute)
+}
+
+func setPerMinuteLimit(limiter *rate.Limiter, perMinute int) {
+	if perMinute <= 0 {
+		limiter.SetLimit(rate.Inf)
+		return
+	}
+
+	limiter.SetLimit(rate.Limit(float64(perMinute) / time.Minute.Seconds()))
+	limiter.SetBurst(perMinute)
+}
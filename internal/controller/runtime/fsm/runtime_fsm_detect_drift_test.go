@@ -0,0 +1,117 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var _ = Describe("KIM sFnDetectDrift", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	setupDriftTest := func(driftDetection config.DriftDetection) (*fsm, *systemState, *gardener.Shoot) {
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = imv1.RuntimeStateReady
+
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.DriftDetection = driftDetection
+
+		s := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+		desiredShoot, err := convertForComparison(context.Background(), fsm, s)
+		Expect(err).ToNot(HaveOccurred())
+		s.shoot = &desiredShoot
+
+		return fsm, s, &desiredShoot
+	}
+
+	driftCondition := func(s *systemState) *metav1.Condition {
+		return meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeDriftDetected))
+	}
+
+	It("should report shoot in sync with the Runtime", func() {
+		// given
+		fsm, s, _ := setupDriftTest(config.DriftDetection{Enabled: true, Interval: metav1.Duration{Duration: time.Hour}})
+
+		// when
+		next, _, _ := sFnDetectDrift(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.LastDriftCheckTime).ToNot(BeNil())
+		Expect(driftCondition(s).Status).To(Equal(metav1.ConditionFalse))
+		Expect(driftCondition(s).Reason).To(Equal(string(imv1.ConditionReasonShootInSync)))
+	})
+
+	It("should report shoot fields changed outside of the Runtime Controller", func() {
+		// given
+		fsm, s, shoot := setupDriftTest(config.DriftDetection{Enabled: true})
+		shoot.Spec.Provider.Workers[0].Maximum = 10
+
+		// when
+		next, _, _ := sFnDetectDrift(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(driftCondition(s).Status).To(Equal(metav1.ConditionTrue))
+		Expect(driftCondition(s).Reason).To(Equal(string(imv1.ConditionReasonShootDriftDetected)))
+		Expect(driftCondition(s).Message).To(ContainSubstring("spec.provider.workers[0].maximum"))
+	})
+
+	It("should patch shoot when remediation is enabled", func() {
+		// given
+		fsm, s, shoot := setupDriftTest(config.DriftDetection{Enabled: true, Remediate: true})
+		shoot.Spec.Provider.Workers[0].Maximum = 10
+
+		// when
+		next, _, _ := sFnDetectDrift(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnPatchExistingShoot"))
+	})
+
+	It("should requeue without check when the interval did not pass", func() {
+		// given
+		fsm, s, shoot := setupDriftTest(config.DriftDetection{Enabled: true, Interval: metav1.Duration{Duration: time.Hour}})
+		shoot.Spec.Provider.Workers[0].Maximum = 10
+		s.instance.Status.LastDriftCheckTime = &metav1.Time{Time: time.Now().Add(-30 * time.Minute)}
+
+		// when
+		_, _, _ = sFnDetectDrift(context.Background(), fsm, s)
+
+		// then
+		Expect(driftCondition(s)).To(BeNil())
+	})
+
+	It("should requeue without check when the rate limit is exceeded", func() {
+		// given
+		fsm, s, _ := setupDriftTest(config.DriftDetection{Enabled: true, ChecksPerMinute: 1})
+		fsm.DriftRateLimiter = NewDriftRateLimiter(fsm.DriftDetection)
+		Expect(fsm.DriftRateLimiter.Allow()).To(BeTrue())
+
+		// when
+		_, _, _ = sFnDetectDrift(context.Background(), fsm, s)
+
+		// then
+		Expect(driftCondition(s)).To(BeNil())
+		Expect(s.instance.Status.LastDriftCheckTime).To(BeNil())
+	})
+
+	It("should not limit checks when checks per minute are not configured", func() {
+		Expect(NewDriftRateLimiter(config.DriftDetection{}).Limit()).To(Equal(rate.Inf))
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -36,8 +37,22 @@ func sFnEmmitEventfunc(next stateFn, result *ctrl.Result, err error) stateFn {
 
 func eventType(condition metav1.Condition) string {
 	eventType := "Normal"
+	// the problem conditions report the issue with the True status
+	if isProblemCondition(condition) {
+		if condition.Status == metav1.ConditionTrue {
+			eventType = "Warning"
+		}
+		return eventType
+	}
+
 	if condition.Status == metav1.ConditionFalse {
 		eventType = "Warning"
 	}
 	return eventType
 }
+
+func isProblemCondition(condition metav1.Condition) bool {
+	return condition.Type == string(imv1.ConditionTypeProvisioningTimeout) ||
+		condition.Type == string(imv1.ConditionTypeDriftDetected) ||
+		condition.Type == string(imv1.ConditionTypeRuntimeExpiring)
+}
@@ -0,0 +1,103 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	eventReasonRuntimeExpired = "RuntimeExpired"
+	// expirationCheckInterval keeps the remaining lifetime metric of the expiring runtimes up to date
+	expirationCheckInterval = time.Hour
+)
+
+// sFnExpireRuntime deletes the Runtime after its expiration time, the shoot is removed with the normal deletion flow,
+// when the soft delete is enabled, the deletion is only requested and the Runtime can be restored until the recovery deadline
+func sFnExpireRuntime(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	expiresAt := s.instance.Spec.ExpiresAt.UTC().Format(time.RFC3339)
+
+	if err := deleteOrRequestDeletion(ctx, m, s); err != nil {
+		m.log.Error(err, "Failed to delete expired Runtime", "RuntimeCR", s.instance.Name, "expiresAt", expiresAt)
+		m.Event(&s.instance, "Warning", eventReasonRuntimeExpired, fmt.Sprintf("Runtime expired at %s, deletion failed: %v: %s/%s", expiresAt, err, s.instance.Namespace, s.instance.Name))
+		return stop()
+	}
+
+	m.log.Info("Runtime expired, deletion triggered", "RuntimeCR", s.instance.Name, "expiresAt", expiresAt)
+	m.Event(&s.instance, "Warning", eventReasonRuntimeExpired, fmt.Sprintf("Runtime expired at %s, deletion triggered: %s/%s", expiresAt, s.instance.Namespace, s.instance.Name))
+	return stop()
+}
+
+func isExpired(runtime imv1.Runtime) bool {
+	return runtime.Spec.ExpiresAt != nil && !time.Now().Before(runtime.Spec.ExpiresAt.Time)
+}
+
+// setExpiringCondition sets the Expiring condition when the first of the warning lead times is reached
+// and exposes the remaining lifetime of the Runtime
+func setExpiringCondition(m *fsm, s *systemState) {
+	if s.instance.Spec.ExpiresAt == nil {
+		meta.RemoveStatusCondition(&s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeExpiring))
+		return
+	}
+
+	remaining := time.Until(s.instance.Spec.ExpiresAt.Time)
+	m.Metrics.SetRuntimeRemainingLifetime(s.instance, remaining)
+
+	leadTime, reached := reachedLeadTime(m.Expiration, remaining)
+	if !reached {
+		// the expiration time could be postponed
+		meta.RemoveStatusCondition(&s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeExpiring))
+		return
+	}
+
+	meta.SetStatusCondition(&s.instance.Status.Conditions, metav1.Condition{
+		Type:    string(imv1.ConditionTypeRuntimeExpiring),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(imv1.ConditionReasonRuntimeExpiring),
+		Message: fmt.Sprintf("Runtime expires at %s, in less than %s", s.instance.Spec.ExpiresAt.UTC().Format(time.RFC3339), leadTime),
+	})
+}
+
+// reachedLeadTime returns the shortest warning lead time which is not shorter than the remaining lifetime
+func reachedLeadTime(cfg config.Expiration, remaining time.Duration) (time.Duration, bool) {
+	var leadTimes []time.Duration
+	for _, leadTime := range cfg.WarningLeadTimes {
+		if leadTime.Duration >= remaining {
+			leadTimes = append(leadTimes, leadTime.Duration)
+		}
+	}
+
+	if len(leadTimes) == 0 {
+		return 0, false
+	}
+
+	return slices.Min(leadTimes), true
+}
+
+// nextExpirationCheck returns the time until the next warning lead time or the expiration of the Runtime, zero is returned when no check is needed
+func nextExpirationCheck(cfg config.Expiration, runtime imv1.Runtime) time.Duration {
+	if runtime.Spec.ExpiresAt == nil || !runtime.GetDeletionTimestamp().IsZero() {
+		return 0
+	}
+
+	remaining := time.Until(runtime.Spec.ExpiresAt.Time)
+	if remaining <= 0 {
+		return 0
+	}
+
+	next := min(remaining, expirationCheckInterval)
+	for _, leadTime := range cfg.WarningLeadTimes {
+		if untilLeadTime := remaining - leadTime.Duration; untilLeadTime > 0 {
+			next = min(next, untilLeadTime)
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,117 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM sFnExpireRuntime", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	expiration := config.Expiration{WarningLeadTimes: []metav1.Duration{
+		{Duration: 72 * time.Hour},
+		{Duration: 24 * time.Hour},
+	}}
+
+	expiringRuntime := func(expiresIn time.Duration) *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Finalizers = []string{"test-me-plz"}
+		runtime.Spec.ExpiresAt = &metav1.Time{Time: time.Now().Add(expiresIn)}
+		return runtime
+	}
+
+	It("should delete expired Runtime", func() {
+		// given
+		ctx := context.Background()
+		runtime := expiringRuntime(-time.Minute)
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime}
+
+		// when
+		next, _, _ := sFnInitialize(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnExpireRuntime"))
+
+		// when
+		next, _, _ = sFnExpireRuntime(ctx, fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+		var deletedRuntime imv1.Runtime
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(runtime), &deletedRuntime)).To(Succeed())
+		Expect(deletedRuntime.DeletionTimestamp).ToNot(BeNil())
+		Expect(<-fsm.EventRecorder.(*record.FakeRecorder).Events).To(HavePrefix("Warning " + eventReasonRuntimeExpired))
+	})
+
+	It("should request deletion of expired Runtime when the soft delete is enabled", func() {
+		// given
+		ctx := context.Background()
+		runtime := expiringRuntime(-time.Minute)
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.SoftDelete.Enabled = true
+		s := &systemState{instance: *runtime}
+
+		// when
+		next, _, _ := sFnExpireRuntime(ctx, fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+		var expiredRuntime imv1.Runtime
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(runtime), &expiredRuntime)).To(Succeed())
+		Expect(expiredRuntime.DeletionTimestamp).To(BeNil())
+		Expect(expiredRuntime.Annotations).To(HaveKeyWithValue(reconciler.DeletionRequestedAnnotation, "true"))
+	})
+
+	DescribeTable("should set Expiring condition",
+		func(expiresIn time.Duration, expectedMessage string) {
+			// given
+			fsm := setupFakeFSMForTest(testScheme)
+			fsm.Expiration = expiration
+			s := &systemState{instance: *expiringRuntime(expiresIn)}
+
+			// when
+			setExpiringCondition(fsm, s)
+
+			// then
+			condition := meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeExpiring))
+			if expectedMessage == "" {
+				Expect(condition).To(BeNil())
+				return
+			}
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(string(imv1.ConditionReasonRuntimeExpiring)))
+			Expect(condition.Message).To(ContainSubstring(expectedMessage))
+		},
+		Entry("when the last lead time is reached", 10*time.Hour, "in less than 24h0m0s"),
+		Entry("when the first lead time is reached", 48*time.Hour, "in less than 72h0m0s"),
+		Entry("not when no lead time is reached", 100*time.Hour, ""),
+	)
+
+	DescribeTable("should requeue expiring Runtime",
+		func(expiresIn, expectedRequeue time.Duration) {
+			Expect(nextExpirationCheck(expiration, *expiringRuntime(expiresIn))).To(BeNumerically("~", expectedRequeue, time.Minute))
+		},
+		Entry("at the next lead time", 24*time.Hour+30*time.Minute, 30*time.Minute),
+		Entry("at the expiration time", 20*time.Minute, 20*time.Minute),
+		Entry("at the check interval", 100*time.Hour, expirationCheckInterval),
+		Entry("not when the Runtime is expired", -time.Minute, time.Duration(0)),
+	)
+})
@@ -5,6 +5,7 @@ import (
 
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -25,6 +26,10 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 	// instance is being deleted
 	if instanceIsBeingDeleted {
 		if s.shoot != nil {
+			// no destructive step is taken for the protected runtime, including the kubeconfig deletion
+			if s.shoot.GetDeletionTimestamp().IsZero() && reconciler.ShouldProtectDeletion(s.instance.Annotations) {
+				return protectDeletionAndStop(m, s)
+			}
 			return switchState(sFnDeleteKubeconfig)
 		}
 
@@ -34,6 +39,19 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 		return stopWithMetrics()
 	}
 
+	// the soft delete is continued until the Runtime is deleted or restored
+	if reconciler.IsDeletionRequested(s.instance.Annotations) || s.instance.Status.RecoveryDeadline != nil {
+		if s.shoot != nil && reconciler.ShouldProtectDeletion(s.instance.Annotations) {
+			return protectDeletionAndStop(m, s)
+		}
+		return switchState(sFnSoftDeleteShoot)
+	}
+
+	if isExpired(s.instance) {
+		return switchState(sFnExpireRuntime)
+	}
+	setExpiringCondition(m, s)
+
 	if s.shoot == nil && provisioningCondition == nil {
 		s.instance.UpdateStatePending(
 			imv1.ConditionTypeRuntimeProvisioned,
@@ -44,6 +62,10 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 		return updateStatusAndRequeue()
 	}
 
+	if reconciler.ShouldAdoptShoot(s.instance.Annotations) {
+		return switchState(sFnAdoptShoot)
+	}
+
 	if s.shoot == nil {
 		m.log.Info("Gardener shoot does not exist, creating new one")
 		return switchState(sFnCreateShoot)
@@ -52,6 +74,17 @@ func sFnInitialize(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.
 	return switchState(sFnSelectShootProcessing)
 }
 
+func protectDeletionAndStop(m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("Runtime is protected against deletion, shoot will not be deleted", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+	s.instance.UpdateStateDeletion(
+		imv1.ConditionTypeRuntimeDeprovisioned,
+		imv1.ConditionReasonDeletionProtected,
+		"False",
+		"Runtime is protected against deletion, remove the deletion protection to delete the shoot",
+	)
+	return updateStatusAndStop()
+}
+
 func addFinalizerAndRequeue(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
 	controllerutil.AddFinalizer(&s.instance, m.Finalizer)
 
@@ -6,6 +6,7 @@ import (
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	. "github.com/onsi/ginkgo/v2" //nolint:revive
 	. "github.com/onsi/gomega"    //nolint:revive
 	"github.com/onsi/gomega/types"
@@ -164,6 +165,24 @@ var _ = Describe("KIM sFnInitialise", func() {
 			},
 		),
 	)
+
+	It("should not take any deletion step for protected Runtime", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(map[string]string{reconciler.DeletionProtectionAnnotation: "true"})
+		runtime.DeletionTimestamp = &now
+		runtime.Finalizers = []string{"test-me-plz"}
+		fsm := must(newFakeFSM, withTestFinalizer, withMockedMetrics(), withDefaultReconcileDuration())
+		fsm.SoftDelete.Enabled = true
+		s := &systemState{instance: *runtime, shoot: &testShoot}
+
+		// when
+		next, _, _ := sFnInitialize(testCtx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStateFailed)))
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeRuntimeDeprovisioned, imv1.ConditionReasonDeletionProtected, metav1.ConditionFalse)).To(BeTrue())
+	})
 })
 
 type testOpts struct {
@@ -0,0 +1,138 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func sFnMetricsTestSwitch(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+	return switchState(sFnMetricsTestRequeue)
+}
+
+func sFnMetricsTestLoadShoot(_ context.Context, _ *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	s.shoot = fsm_testing.TestShootForPatch()
+	return switchState(sFnPatchExistingShoot)
+}
+
+func sFnMetricsTestRequeue(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+	return requeueAfter(time.Minute)
+}
+
+var _ = Describe("KIM fsm metrics", func() {
+	DescribeTable("should determine state function outcome",
+		func(next stateFn, result *ctrl.Result, err error, expected string) {
+			Expect(stateOutcome(next, result, err)).To(Equal(expected))
+		},
+		Entry("switch", stateFn(sFnMetricsTestRequeue), nil, nil, outcomeSwitch),
+		Entry("requeue", nil, &ctrl.Result{Requeue: true}, nil, outcomeRequeue),
+		Entry("requeue after", nil, &ctrl.Result{RequeueAfter: time.Second}, nil, outcomeRequeue),
+		Entry("stop", nil, nil, nil, outcomeStop),
+		Entry("stop with empty result", nil, &ctrl.Result{}, nil, outcomeStop),
+		Entry("error", stateFn(sFnMetricsTestRequeue), nil, errors.New("test error"), outcomeError),
+	)
+
+	It("should observe every executed state function", func() {
+		// given
+		m := &mocks.Metrics{}
+		m.On("ObserveRuntimeFSMState", "sFnMetricsTestSwitch", outcomeSwitch, mock.Anything).Return().Once()
+		m.On("ObserveRuntimeFSMState", "sFnMetricsTestRequeue", outcomeRequeue, mock.Anything).Return().Once()
+		testScheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(testScheme))
+		fsm := must(newFakeFSM, withMetrics(m), withFakedK8sClient(testScheme))
+		fsm.fn = sFnMetricsTestSwitch
+
+		// when
+		result, err := fsm.Run(context.Background(), imv1.Runtime{})
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		m.AssertExpectations(GinkgoT())
+	})
+
+	It("should count the patch conflict against sFnPatchExistingShoot as requeue", func() {
+		// given
+		testScheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(testScheme))
+		util.Must(gardener.AddToScheme(testScheme))
+		util.Must(corev1.AddToScheme(testScheme))
+
+		rt := makeInputRuntimeWithAnnotation(nil)
+		conflictErr := k8serrors.NewConflict(schema.GroupResource{Group: "core.gardener.cloud", Resource: "shoot"}, "test-shoot", errors.New("test conflict"))
+
+		m := &mocks.Metrics{}
+		m.On("SetRuntimeStates", mock.Anything).Return()
+		m.On("ObserveRuntimeFSMState", "sFnMetricsTestLoadShoot", outcomeSwitch, mock.Anything).Return().Once()
+		m.On("ObserveRuntimeFSMState", "sFnPatchExistingShoot", outcomeRequeue, mock.Anything).Return().Once()
+
+		fsm := must(newFakeFSM,
+			withMetrics(m),
+			withShootNamespace("garden-"),
+			withTestFinalizer,
+			withFakedK8sClientFailPatchError(conflictErr, testScheme, rt),
+			withFakeEventRecorder(5),
+			withDefaultReconcileDuration(),
+		)
+		fsm.fn = sFnMetricsTestLoadShoot
+
+		// when
+		result, err := fsm.Run(context.Background(), *rt)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", 0))
+		m.AssertExpectations(GinkgoT())
+		m.AssertNotCalled(GinkgoT(), "ObserveRuntimeFSMState", "sFnUpdateStatus", mock.Anything, mock.Anything)
+		m.AssertNotCalled(GinkgoT(), "ObserveRuntimeFSMState", "sFnEmmitEventfunc", mock.Anything, mock.Anything)
+	})
+
+	It("should observe time to ready when provisioning gets completed", func() {
+		// given
+		testScheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(testScheme))
+
+		rt := imv1.Runtime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "test-runtime",
+				Namespace:         "kcp-system",
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-30 * time.Minute)),
+			},
+		}
+
+		m := &mocks.Metrics{}
+		m.On("SetRuntimeStates", mock.Anything).Return()
+		m.On("ObserveRuntimeTimeToReady", mock.Anything, mock.MatchedBy(func(d time.Duration) bool {
+			return d >= 30*time.Minute
+		})).Return().Once()
+
+		fsm := must(newFakeFSM, withMetrics(m), withFakedK8sClient(testScheme, &rt))
+		fsm.EventRecorder = record.NewFakeRecorder(5)
+
+		s := &systemState{instance: rt}
+		s.instance.Status.ProvisioningCompleted = true
+
+		// when
+		_, _, err := sFnUpdateStatus(nil, nil)(context.Background(), fsm, s)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		m.AssertExpectations(GinkgoT())
+	})
+})
@@ -0,0 +1,75 @@
+package fsm
+
+import (
+	"fmt"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	shootOperationCreate = "create"
+	shootOperationUpdate = "update"
+	shootOperationDelete = "delete"
+)
+
+type shootOperationTimeout struct {
+	timeout       time.Duration
+	reason        imv1.RuntimeConditionReason
+	conditionType imv1.RuntimeConditionType
+}
+
+func (m *fsm) shootOperationTimeout(operation string) shootOperationTimeout {
+	switch operation {
+	case shootOperationCreate:
+		return shootOperationTimeout{m.TimeoutsConfig.ShootCreate.Duration, imv1.ConditionReasonShootCreationTimeout, imv1.ConditionTypeRuntimeProvisioned}
+	case shootOperationUpdate:
+		return shootOperationTimeout{m.TimeoutsConfig.ShootUpdate.Duration, imv1.ConditionReasonShootUpdateTimeout, imv1.ConditionTypeRuntimeProvisioned}
+	case shootOperationDelete:
+		return shootOperationTimeout{m.TimeoutsConfig.ShootDelete.Duration, imv1.ConditionReasonShootDeletionTimeout, imv1.ConditionTypeRuntimeDeprovisioned}
+	}
+	return shootOperationTimeout{}
+}
+
+// setTimeoutConditionIfExceeded sets the ProvisioningTimeout condition when the shoot operation is in progress for longer than configured.
+// The time is measured from the last transition of the condition tracking the operation. Returns true if the condition was set in this call.
+func setTimeoutConditionIfExceeded(m *fsm, s *systemState, operation string) bool {
+	opTimeout := m.shootOperationTimeout(operation)
+	if opTimeout.timeout <= 0 {
+		return false
+	}
+
+	condition := meta.FindStatusCondition(s.instance.Status.Conditions, string(opTimeout.conditionType))
+	if condition == nil || condition.Status != metav1.ConditionUnknown {
+		return false
+	}
+
+	elapsed := time.Since(condition.LastTransitionTime.Time)
+	if elapsed <= opTimeout.timeout {
+		return false
+	}
+
+	if s.instance.IsConditionSetWithStatus(imv1.ConditionTypeProvisioningTimeout, opTimeout.reason, metav1.ConditionTrue) {
+		return false
+	}
+
+	msg := fmt.Sprintf("Shoot %s operation in progress for %s, exceeding the timeout of %s", operation, elapsed.Round(time.Second), opTimeout.timeout)
+	m.log.Info(msg, "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+
+	meta.SetStatusCondition(&s.instance.Status.Conditions, metav1.Condition{
+		Type:    string(imv1.ConditionTypeProvisioningTimeout),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(opTimeout.reason),
+		Message: msg,
+	})
+	m.Metrics.IncRuntimeOperationTimeoutCounter(s.instance, operation)
+
+	return true
+}
+
+// clearTimeoutCondition removes the ProvisioningTimeout condition once the shoot operation ended, either succeeded or failed
+func clearTimeoutCondition(instance *imv1.Runtime) {
+	meta.RemoveStatusCondition(&instance.Status.Conditions, string(imv1.ConditionTypeProvisioningTimeout))
+}
@@ -0,0 +1,180 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/metrics/mocks"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"github.com/stretchr/testify/mock"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("KIM sFnWaitForShoot operation timeouts", func() {
+	fixProcessingShoot := func() *gardener.Shoot {
+		return &gardener.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: "garden-test"},
+			Status: gardener.ShootStatus{
+				LastOperation: &gardener.LastOperation{State: gardener.LastOperationStateProcessing},
+			},
+		}
+	}
+
+	fixRuntimeWithCondition := func(c imv1.RuntimeConditionType, since time.Duration) imv1.Runtime {
+		rt := imv1.Runtime{ObjectMeta: metav1.ObjectMeta{Name: "test-runtime", Namespace: "kcp-system"}}
+		rt.Status.Conditions = []metav1.Condition{{
+			Type:               string(c),
+			Status:             metav1.ConditionUnknown,
+			Reason:             string(imv1.ConditionReasonProcessing),
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-since)),
+		}}
+		return rt
+	}
+
+	It("should set ProvisioningTimeout condition when shoot creation exceeds the timeout", func() {
+		// given
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeOperationTimeoutCounter", mock.Anything, shootOperationCreate).Return().Once()
+		fsm := must(newFakeFSM, withMetrics(m), withTimeouts(time.Hour, 0, 0))
+		s := &systemState{
+			instance: fixRuntimeWithCondition(imv1.ConditionTypeRuntimeProvisioned, 2*time.Hour),
+			shoot:    fixProcessingShoot(),
+		}
+
+		// when
+		next, _, _ := sFnWaitForShootCreation(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeProvisioningTimeout, imv1.ConditionReasonShootCreationTimeout, metav1.ConditionTrue)).To(BeTrue())
+		m.AssertExpectations(GinkgoT())
+	})
+
+	It("should not set ProvisioningTimeout condition when shoot update is within the timeout", func() {
+		// given
+		m := &mocks.Metrics{}
+		fsm := must(newFakeFSM, withMetrics(m), withTimeouts(0, time.Hour, 0))
+		s := &systemState{
+			instance: fixRuntimeWithCondition(imv1.ConditionTypeRuntimeProvisioned, 30*time.Minute),
+			shoot:    fixProcessingShoot(),
+		}
+
+		// when
+		_, _, _ = sFnWaitForShootReconcile(context.Background(), fsm, s)
+
+		// then
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeProvisioningTimeout))).To(BeNil())
+		m.AssertNotCalled(GinkgoT(), "IncRuntimeOperationTimeoutCounter", mock.Anything, mock.Anything)
+	})
+
+	It("should not set ProvisioningTimeout condition when timeout is not configured", func() {
+		// given
+		m := &mocks.Metrics{}
+		fsm := must(newFakeFSM, withMetrics(m))
+		s := &systemState{
+			instance: fixRuntimeWithCondition(imv1.ConditionTypeRuntimeProvisioned, 48*time.Hour),
+			shoot:    fixProcessingShoot(),
+		}
+
+		// when
+		_, _, _ = sFnWaitForShootReconcile(context.Background(), fsm, s)
+
+		// then
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeProvisioningTimeout))).To(BeNil())
+	})
+
+	It("should count the timeout only once", func() {
+		// given
+		m := &mocks.Metrics{}
+		fsm := must(newFakeFSM, withMetrics(m), withTimeouts(0, time.Hour, 0))
+		rt := fixRuntimeWithCondition(imv1.ConditionTypeRuntimeProvisioned, 2*time.Hour)
+		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+			Type:   string(imv1.ConditionTypeProvisioningTimeout),
+			Status: metav1.ConditionTrue,
+			Reason: string(imv1.ConditionReasonShootUpdateTimeout),
+		})
+		s := &systemState{instance: rt, shoot: fixProcessingShoot()}
+
+		// when
+		exceeded := setTimeoutConditionIfExceeded(fsm, s, shootOperationUpdate)
+
+		// then
+		Expect(exceeded).To(BeFalse())
+		m.AssertNotCalled(GinkgoT(), "IncRuntimeOperationTimeoutCounter", mock.Anything, mock.Anything)
+	})
+
+	It("should update status when shoot deletion exceeds the timeout", func() {
+		// given
+		m := &mocks.Metrics{}
+		m.On("IncRuntimeOperationTimeoutCounter", mock.Anything, shootOperationDelete).Return().Once()
+		fsm := must(newFakeFSM, withMetrics(m), withTimeouts(0, 0, time.Hour))
+		shoot := fixProcessingShoot()
+		shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		s := &systemState{
+			instance: fixRuntimeWithCondition(imv1.ConditionTypeRuntimeDeprovisioned, 2*time.Hour),
+			shoot:    shoot,
+		}
+
+		// when
+		next, _, _ := sFnDeleteShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeProvisioningTimeout, imv1.ConditionReasonShootDeletionTimeout, metav1.ConditionTrue)).To(BeTrue())
+		m.AssertExpectations(GinkgoT())
+	})
+
+	It("should remove ProvisioningTimeout condition when shoot creation succeeds", func() {
+		// given
+		fsm := must(newFakeFSM, withMockedMetrics(), withTimeouts(time.Hour, 0, 0))
+		rt := fixRuntimeWithCondition(imv1.ConditionTypeRuntimeProvisioned, 2*time.Hour)
+		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+			Type:   string(imv1.ConditionTypeProvisioningTimeout),
+			Status: metav1.ConditionTrue,
+			Reason: string(imv1.ConditionReasonShootCreationTimeout),
+		})
+		shoot := fixProcessingShoot()
+		shoot.Status.LastOperation.State = gardener.LastOperationStateSucceeded
+		s := &systemState{instance: rt, shoot: shoot}
+
+		// when
+		_, _, _ = sFnWaitForShootCreation(context.Background(), fsm, s)
+
+		// then
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeProvisioningTimeout))).To(BeNil())
+	})
+
+	It("should remove ProvisioningTimeout condition when shoot update fails", func() {
+		// given
+		fsm := must(newFakeFSM, withMockedMetrics(), withTimeouts(0, time.Hour, 0))
+		rt := fixRuntimeWithCondition(imv1.ConditionTypeRuntimeProvisioned, 2*time.Hour)
+		meta.SetStatusCondition(&rt.Status.Conditions, metav1.Condition{
+			Type:   string(imv1.ConditionTypeProvisioningTimeout),
+			Status: metav1.ConditionTrue,
+			Reason: string(imv1.ConditionReasonShootUpdateTimeout),
+		})
+		shoot := fixProcessingShoot()
+		shoot.Status.LastOperation.State = gardener.LastOperationStateFailed
+		s := &systemState{instance: rt, shoot: shoot}
+
+		// when
+		next, _, _ := sFnWaitForShootReconcile(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStateFailed)))
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeProvisioningTimeout))).To(BeNil())
+	})
+
+	It("should emit warning event for ProvisioningTimeout condition", func() {
+		Expect(eventType(metav1.Condition{
+			Type:   string(imv1.ConditionTypeProvisioningTimeout),
+			Status: metav1.ConditionTrue,
+		})).To(Equal("Warning"))
+	})
+})
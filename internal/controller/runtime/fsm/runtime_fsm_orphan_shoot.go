@@ -0,0 +1,34 @@
+package fsm
+
+import (
+	"context"
+
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// sFnOrphanShoot detaches the shoot from the deleted Runtime, the shoot is kept running without the annotations set by KIM
+func sFnOrphanShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	if !s.shoot.GetDeletionTimestamp().IsZero() {
+		m.log.Info("Shoot is already being deleted, it can't be orphaned", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return switchState(sFnDeleteShoot)
+	}
+
+	original := s.shoot.DeepCopy()
+	for _, annotation := range []string{
+		extender.ShootRuntimeGenerationAnnotation,
+		extender.ShootRuntimeIDAnnotation,
+		extender.ShootLicenceTypeAnnotation,
+	} {
+		delete(s.shoot.Annotations, annotation)
+	}
+
+	if err := m.ShootClient.Patch(ctx, s.shoot, client.MergeFrom(original)); err != nil {
+		m.log.Error(err, "Failed to remove KIM annotations from shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	m.log.Info("Shoot orphaned, removing the Runtime without deleting the shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	return removeFinalizerAndStop(ctx, m, s)
+}
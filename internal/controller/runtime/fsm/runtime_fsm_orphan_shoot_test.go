@@ -0,0 +1,102 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM sFnOrphanShoot", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	deletedRuntime := func() *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(map[string]string{reconciler.DeletionPolicyAnnotation: reconciler.DeletionPolicyOrphan})
+		runtime.Finalizers = []string{"test-me-plz"}
+		runtime.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		runtime.Status.State = imv1.RuntimeStateTerminating
+		runtime.Status.Conditions = []metav1.Condition{{
+			Type:   string(imv1.ConditionTypeRuntimeDeprovisioned),
+			Status: metav1.ConditionTrue,
+			Reason: string(imv1.ConditionReasonGardenerCRDeleted),
+		}}
+		return runtime
+	}
+
+	orphanedShoot := func() *gardener.Shoot {
+		shoot := fsm_testing.TestShootForPatch()
+		shoot.Namespace = "garden-"
+		shoot.Annotations = map[string]string{
+			extender.ShootRuntimeGenerationAnnotation: "1",
+			extender.ShootRuntimeIDAnnotation:         "runtime-id",
+			"custom-annotation":                       "kept",
+		}
+		return shoot
+	}
+
+	It("should switch to sFnOrphanShoot after GardenerCluster is deleted", func() {
+		// given
+		runtime := deletedRuntime()
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime, shoot: orphanedShoot()}
+
+		// when
+		next, _, _ := sFnDeleteKubeconfig(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnOrphanShoot"))
+	})
+
+	It("should remove KIM annotations from shoot and remove the finalizer", func() {
+		// given
+		ctx := context.Background()
+		runtime := deletedRuntime()
+		shoot := orphanedShoot()
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnOrphanShoot(ctx, fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+
+		var keptShoot gardener.Shoot
+		Expect(fsm.ShootClient.Get(ctx, client.ObjectKeyFromObject(shoot), &keptShoot)).To(Succeed())
+		Expect(keptShoot.DeletionTimestamp).To(BeNil())
+		Expect(keptShoot.Annotations).To(Equal(map[string]string{"custom-annotation": "kept"}))
+
+		err := fsm.Get(ctx, client.ObjectKeyFromObject(runtime), &imv1.Runtime{})
+		Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should continue with shoot deletion when the shoot is already being deleted", func() {
+		// given
+		runtime := deletedRuntime()
+		shoot := orphanedShoot()
+		shoot.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnOrphanShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnDeleteShoot"))
+	})
+})
@@ -14,6 +14,8 @@ import (
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
 	"github.com/kyma-project/infrastructure-manager/internal/registrycache"
 	gardener_shoot "github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/diff"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/auditlogs"
 	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
 	"github.com/kyma-project/kim-snatch/api/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,9 +25,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-const fieldManagerName = "kim"
+const (
+	fieldManagerName        = "kim"
+	eventReasonShootPatched = "ShootPatched"
+)
 
 func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	if isDryRun(m, s) {
+		return switchState(sFnPlanShootPatch)
+	}
+	// the shoot is refreshed by the structured authentication migration, the changes are reported against the shoot before the patch
+	shootBeforePatch := s.shoot.DeepCopy()
+
 	data, err := m.AuditLogging.GetAuditLogData(
 		s.instance.Spec.Shoot.Provider.Type,
 		s.instance.Spec.Shoot.Region)
@@ -81,20 +92,7 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 	}
 
 	// NOTE: In the future we want to pass the whole shoot object here
-	updatedShoot, err := convertPatch(&s.instance, gardener_shoot.PatchOpts{
-		ConverterConfig:       m.ConverterConfig,
-		AuditLogData:          data,
-		MaintenanceTimeWindow: getMaintenanceTimeWindow(s, m),
-		Workers:               s.shoot.Spec.Provider.Workers,
-		ShootK8SVersion:       s.shoot.Spec.Kubernetes.Version,
-		Extensions:            s.shoot.Spec.Extensions,
-		Resources:             s.shoot.Spec.Resources,
-		InfrastructureConfig:  s.shoot.Spec.Provider.InfrastructureConfig,
-		ControlPlaneConfig:    s.shoot.Spec.Provider.ControlPlaneConfig,
-		Log:                   ptr.To(m.log),
-		StructuredAuthEnabled: m.StructuredAuthEnabled,
-		RegistryCache:         registrycache,
-	})
+	updatedShoot, err := convertPatch(&s.instance, patchOpts(m, s, data, registrycache))
 
 	if err != nil {
 		m.log.Error(err, "Failed to convert Runtime instance to shoot object, exiting with no retry")
@@ -179,6 +177,7 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 	}
 
 	m.log.V(log_level.DEBUG).Info("Gardener shoot for runtime patched successfully", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+	recordShootChanges(m, s, *shootBeforePatch, updatedShoot)
 
 	s.instance.UpdateStatePending(
 		imv1.ConditionTypeRuntimeProvisioned,
@@ -190,6 +189,26 @@ func sFnPatchExistingShoot(ctx context.Context, m *fsm, s *systemState) (stateFn
 	return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
 }
 
+// recordShootChanges publishes the shoot spec fields changed or removed by the patch, so the shoot changes can be traced back to the Runtime generation
+func recordShootChanges(m *fsm, s *systemState, shootBeforePatch, patchedShoot gardener.Shoot) {
+	changes, err := diff.ShootSpecChanges(shootBeforePatch, patchedShoot)
+	if err != nil {
+		m.log.Error(err, "Failed to compare shoot after patch", "Name", s.shoot.Name, "Namespace", s.shoot.Namespace)
+		return
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	for _, change := range changes {
+		m.log.V(log_level.DEBUG).Info("Shoot field changed by patch", "Name", s.shoot.Name, "generation", s.instance.Generation, "change", change.String())
+	}
+
+	m.Event(&s.instance, "Normal", eventReasonShootPatched, fmt.Sprintf("Shoot patched for Runtime generation %d, %d field(s) changed: %s: %s/%s",
+		s.instance.Generation, len(changes), changedPaths(changes), s.instance.Namespace, s.instance.Name))
+}
+
 func handleUpdateError(err error, m *fsm, s *systemState, errMsg, statusMsg string) (stateFn, *ctrl.Result, error) {
 	if err != nil {
 		if k8serrors.IsConflict(err) {
@@ -288,6 +307,24 @@ func handleForceReconciliationAnnotation(runtime *imv1.Runtime, fsm *fsm, ctx co
 	return nil
 }
 
+func patchOpts(m *fsm, s *systemState, data auditlogs.AuditLogData, registrycache []v1beta1.RegistryCache) gardener_shoot.PatchOpts {
+	return gardener_shoot.PatchOpts{
+		ConverterConfig:       m.ConverterConfig,
+		AuditLogData:          data,
+		MaintenanceTimeWindow: getMaintenanceTimeWindow(s, m),
+		Workers:               s.shoot.Spec.Provider.Workers,
+		ShootK8SVersion:       s.shoot.Spec.Kubernetes.Version,
+		Extensions:            s.shoot.Spec.Extensions,
+		Resources:             s.shoot.Spec.Resources,
+		InfrastructureConfig:  s.shoot.Spec.Provider.InfrastructureConfig,
+		ControlPlaneConfig:    s.shoot.Spec.Provider.ControlPlaneConfig,
+		Log:                   ptr.To(m.log),
+		StructuredAuthEnabled: m.StructuredAuthEnabled,
+		RegistryCache:         registrycache,
+		Hibernation:           s.shoot.Spec.Hibernation,
+	}
+}
+
 func convertPatch(instance *imv1.Runtime, opts gardener_shoot.PatchOpts) (gardener.Shoot, error) {
 	if err := instance.ValidateRequiredLabels(); err != nil {
 		return gardener.Shoot{}, err
@@ -10,6 +10,7 @@ import (
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
@@ -583,3 +584,56 @@ func TestWorkersAreEqual(t *testing.T) {
 		})
 	}
 }
+
+var _ = Describe("KIM recordShootChanges", func() {
+	It("should publish event with the shoot fields changed by the patch", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Generation = 7
+		fsm := must(newFakeFSM, withMockedMetrics(), withFakeEventRecorder(1))
+		previousShoot := fsm_testing.TestShootForPatch()
+		patchedShoot := previousShoot.DeepCopy()
+		patchedShoot.Spec.Kubernetes.Version = "1.32.1"
+		patchedShoot.Spec.Provider.Workers[0].Maximum = 10
+		s := &systemState{instance: *runtime, shoot: previousShoot}
+
+		// when
+		recordShootChanges(fsm, s, *previousShoot, *patchedShoot)
+
+		// then
+		event := <-fsm.EventRecorder.(*record.FakeRecorder).Events
+		Expect(event).To(HavePrefix("Normal " + eventReasonShootPatched))
+		Expect(event).To(ContainSubstring("Runtime generation 7, 2 field(s) changed: spec.kubernetes.version, spec.provider.workers[0].maximum"))
+	})
+
+	It("should publish event with the shoot fields removed by the patch", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Generation = 8
+		fsm := must(newFakeFSM, withMockedMetrics(), withFakeEventRecorder(1))
+		previousShoot := fsm_testing.TestShootForPatch()
+		previousShoot.Spec.Provider.Workers[0].Labels = map[string]string{"team": "kyma"}
+		patchedShoot := fsm_testing.TestShootForPatch()
+		s := &systemState{instance: *runtime, shoot: patchedShoot}
+
+		// when
+		recordShootChanges(fsm, s, *previousShoot, *patchedShoot)
+
+		// then
+		event := <-fsm.EventRecorder.(*record.FakeRecorder).Events
+		Expect(event).To(ContainSubstring("Runtime generation 8, 1 field(s) changed: spec.provider.workers[0].labels"))
+	})
+
+	It("should not publish event when the shoot did not change", func() {
+		// given
+		fsm := must(newFakeFSM, withMockedMetrics(), withFakeEventRecorder(1))
+		shoot := fsm_testing.TestShootForPatch()
+		s := &systemState{instance: *makeInputRuntimeWithAnnotation(nil), shoot: shoot}
+
+		// when
+		recordShootChanges(fsm, s, *shoot, *shoot.DeepCopy())
+
+		// then
+		Expect(fsm.EventRecorder.(*record.FakeRecorder).Events).To(BeEmpty())
+	})
+})
@@ -0,0 +1,88 @@
+package fsm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/diff"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	eventReasonPatchPlanned = "ShootPatchPlanned"
+	maxPlannedChanges       = 50
+	maxPlannedValueLength   = 256
+)
+
+// sFnPlanShootPatch stores the changes the shoot patch would apply in the Runtime status instead of patching the shoot
+func sFnPlanShootPatch(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	desiredShoot, err := convertForComparison(ctx, m, s)
+	if err != nil {
+		m.log.Error(err, "Failed to convert Runtime for patch plan", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return updateStatePendingWithErrorAndStop(&s.instance, imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonConversionError, fmt.Sprintf("Runtime conversion error %v", err))
+	}
+
+	changes, err := diff.ShootSpec(desiredShoot, *s.shoot)
+	if err != nil {
+		m.log.Error(err, "Failed to compare shoot for patch plan, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	s.instance.Status.PatchPlan = patchPlan(s.instance.Generation, changes)
+
+	msg := fmt.Sprintf("Dry run, shoot patch would change %d field(s)", len(changes))
+	if len(changes) > 0 {
+		msg = fmt.Sprintf("%s: %s", msg, changedPaths(changes))
+	}
+	m.log.Info(msg, "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	m.Event(&s.instance, "Normal", eventReasonPatchPlanned, fmt.Sprintf("%s: %s/%s", msg, s.instance.Namespace, s.instance.Name))
+
+	return updateStatusAndStop()
+}
+
+func isDryRun(m *fsm, s *systemState) bool {
+	return m.PatchDryRun || reconciler.ShouldDryRun(s.instance.Annotations)
+}
+
+func patchPlan(generation int64, changes []diff.Change) *imv1.PatchPlan {
+	plan := &imv1.PatchPlan{
+		Time:              metav1.NewTime(time.Now()),
+		RuntimeGeneration: generation,
+		TotalChanges:      len(changes),
+	}
+
+	for i, change := range changes {
+		if i == maxPlannedChanges {
+			break
+		}
+
+		plan.Changes = append(plan.Changes, imv1.FieldChange{
+			Path:    change.Path,
+			Current: plannedValue(change.Actual),
+			Desired: plannedValue(change.Desired),
+		})
+	}
+
+	return plan
+}
+
+func plannedValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	if len(encoded) > maxPlannedValueLength {
+		return string(encoded[:maxPlannedValueLength]) + "..."
+	}
+	return string(encoded)
+}
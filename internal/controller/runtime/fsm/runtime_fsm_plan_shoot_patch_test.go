@@ -0,0 +1,115 @@
+package fsm
+
+import (
+	"context"
+	"strings"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+var _ = Describe("KIM sFnPlanShootPatch", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	setupPlanTest := func(annotations map[string]string) (*fsm, *systemState) {
+		runtime := makeInputRuntimeWithAnnotation(annotations)
+		runtime.Generation = 3
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+
+		s := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+		existingShoot, err := convertForComparison(context.Background(), fsm, s)
+		Expect(err).ToNot(HaveOccurred())
+		s.shoot = &existingShoot
+
+		return fsm, s
+	}
+
+	It("should switch to sFnPlanShootPatch for Runtime with the dry-run annotation", func() {
+		// given
+		fsm, s := setupPlanTest(map[string]string{reconciler.DryRunAnnotation: "true"})
+
+		// when
+		next, _, _ := sFnPatchExistingShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnPlanShootPatch"))
+	})
+
+	It("should switch to sFnPlanShootPatch when dry-run is enabled globally", func() {
+		// given
+		fsm, s := setupPlanTest(nil)
+		fsm.PatchDryRun = true
+
+		// when
+		next, _, _ := sFnPatchExistingShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnPlanShootPatch"))
+	})
+
+	It("should store the planned changes and publish event", func() {
+		// given
+		fsm, s := setupPlanTest(map[string]string{reconciler.DryRunAnnotation: "true"})
+		s.shoot.Spec.Provider.Workers[0].Maximum = 10
+
+		// when
+		next, _, _ := sFnPlanShootPatch(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.PatchPlan).ToNot(BeNil())
+		Expect(s.instance.Status.PatchPlan.RuntimeGeneration).To(Equal(int64(3)))
+		Expect(s.instance.Status.PatchPlan.TotalChanges).To(Equal(1))
+		Expect(s.instance.Status.PatchPlan.Changes).To(Equal([]imv1.FieldChange{{
+			Path:    "spec.provider.workers[0].maximum",
+			Current: "10",
+			Desired: "1",
+		}}))
+
+		event := <-fsm.EventRecorder.(*record.FakeRecorder).Events
+		Expect(event).To(HavePrefix("Normal " + eventReasonPatchPlanned))
+	})
+
+	It("should truncate long values", func() {
+		Expect(plannedValue(strings.Repeat("a", 2*maxPlannedValueLength))).To(HaveLen(maxPlannedValueLength + 3))
+	})
+
+	It("should clear the patch plan when the dry-run mode is disabled", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.PatchPlan = &imv1.PatchPlan{RuntimeGeneration: 3, TotalChanges: 1}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime}
+
+		// when
+		_, _, _ = sFnTakeSnapshot(context.Background(), fsm, s)
+
+		// then
+		Expect(s.instance.Status.PatchPlan).To(BeNil())
+	})
+
+	It("should keep the patch plan while the dry-run mode is enabled", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(map[string]string{reconciler.DryRunAnnotation: "true"})
+		runtime.Status.PatchPlan = &imv1.PatchPlan{RuntimeGeneration: 3, TotalChanges: 1}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime}
+
+		// when
+		_, _, _ = sFnTakeSnapshot(context.Background(), fsm, s)
+
+		// then
+		Expect(s.instance.Status.PatchPlan).ToNot(BeNil())
+	})
+})
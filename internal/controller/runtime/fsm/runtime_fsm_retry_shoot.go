@@ -0,0 +1,128 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultRetryInitialDelay = time.Minute
+	// retryPickupTimeout limits how long the failed shoot operation is ignored after the retry was requested
+	retryPickupTimeout = 5 * time.Minute
+)
+
+// sFnRetryShoot requests the Gardener retry operation for the failed shoot and moves the Runtime back to Pending.
+// The automatic retries are counted, the retry requested with the annotation resets the counter.
+func sFnRetryShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	manualRetry := reconciler.ShouldRetry(s.instance.Annotations)
+	if manualRetry {
+		m.log.Info("Retry annotation found, removing the annotation and retrying the shoot operation", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		annotations := s.instance.Annotations
+		delete(annotations, reconciler.RetryAnnotation)
+		s.instance.SetAnnotations(annotations)
+
+		if err := m.Update(ctx, &s.instance); err != nil {
+			m.log.Error(err, "Failed to remove retry annotation, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	if s.shoot.Status.LastOperation != nil && s.shoot.Status.LastOperation.State == gardener.LastOperationStateFailed {
+		original := s.shoot.DeepCopy()
+		metav1.SetMetaDataAnnotation(&s.shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRetry)
+
+		if err := m.ShootClient.Patch(ctx, s.shoot, client.MergeFrom(original)); err != nil {
+			m.log.Error(err, "Failed to request retry operation for shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	if manualRetry {
+		s.instance.Status.RetryAttempts = 0
+	} else {
+		s.instance.Status.RetryAttempts++
+	}
+	s.instance.Status.LastRetryTime = &metav1.Time{Time: time.Now()}
+
+	msg := fmt.Sprintf("Retry of the failed shoot operation requested, automatic attempts: %d", s.instance.Status.RetryAttempts)
+	m.log.Info(msg, "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonShootRetryRequested,
+		"Unknown",
+		msg)
+
+	return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+}
+
+// resetRetryAttempts resets the automatic retries once the shoot operation succeeded, so the next failure starts with the initial delay
+func resetRetryAttempts(instance *imv1.Runtime) {
+	instance.Status.RetryAttempts = 0
+	instance.Status.LastRetryTime = nil
+}
+
+// isRetryPending returns true when the Gardener retry operation was requested recently and the failed shoot operation was not updated since then
+func isRetryPending(s *systemState) bool {
+	lastRetry := s.instance.Status.LastRetryTime
+	if lastRetry == nil || s.shoot.Status.LastOperation == nil {
+		return false
+	}
+
+	return lastRetry.After(s.shoot.Status.LastOperation.LastUpdateTime.Time) && time.Since(lastRetry.Time) < retryPickupTimeout
+}
+
+// shouldRetryAutomatically returns true when the retry policy allows another automatic retry of the failed shoot operation
+func shouldRetryAutomatically(policy config.RetryPolicy, s *systemState) bool {
+	if !policy.Enabled || s.instance.Status.RetryAttempts >= policy.MaxAttempts {
+		return false
+	}
+
+	if len(policy.ErrorCodes) == 0 {
+		return true
+	}
+
+	for _, lastError := range s.shoot.Status.LastErrors {
+		for _, code := range lastError.Codes {
+			if slices.Contains(policy.ErrorCodes, code) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// retryDelay returns the time remaining until the next automatic retry, measured from the failure of the shoot operation
+func retryDelay(policy config.RetryPolicy, s *systemState) time.Duration {
+	delay := float64(defaultRetryInitialDelay)
+	if policy.InitialDelay.Duration > 0 {
+		delay = float64(policy.InitialDelay.Duration)
+	}
+
+	delay *= math.Pow(math.Max(policy.Factor, 1), float64(s.instance.Status.RetryAttempts))
+
+	maxDelay := float64(policy.MaxDelay.Duration)
+	if maxDelay > 0 && delay > maxDelay {
+		delay = maxDelay
+	}
+
+	condition := meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeProvisioned))
+	if condition == nil {
+		return time.Duration(delay)
+	}
+
+	return time.Until(condition.LastTransitionTime.Add(time.Duration(delay)))
+}
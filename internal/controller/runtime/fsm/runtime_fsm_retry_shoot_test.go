@@ -0,0 +1,176 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM sFnRetryShoot", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	retryPolicy := config.RetryPolicy{
+		Enabled:      true,
+		MaxAttempts:  2,
+		InitialDelay: metav1.Duration{Duration: 10 * time.Minute},
+		ErrorCodes:   []gardener.ErrorCode{gardener.ErrorInfraDependencies},
+	}
+
+	failedShoot := func(code gardener.ErrorCode) *gardener.Shoot {
+		shoot := fsm_testing.TestShootForPatch()
+		shoot.Namespace = "garden-"
+		shoot.Status.LastOperation = &gardener.LastOperation{
+			Type:           gardener.LastOperationTypeReconcile,
+			State:          gardener.LastOperationStateFailed,
+			LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour)),
+		}
+		shoot.Status.LastErrors = []gardener.LastError{{Codes: []gardener.ErrorCode{code}}}
+		return shoot
+	}
+
+	failedRuntime := func(annotations map[string]string, failedAgo time.Duration) *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(annotations)
+		runtime.Status.State = imv1.RuntimeStateFailed
+		runtime.Status.Conditions = []metav1.Condition{{
+			Type:               string(imv1.ConditionTypeRuntimeProvisioned),
+			Status:             metav1.ConditionFalse,
+			Reason:             string(imv1.ConditionReasonProcessingErr),
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-failedAgo)),
+		}}
+		return runtime
+	}
+
+	It("should request Gardener retry operation and count the automatic attempt", func() {
+		// given
+		ctx := context.Background()
+		runtime := failedRuntime(nil, time.Hour)
+		shoot := failedShoot(gardener.ErrorInfraDependencies)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.RetryPolicy = retryPolicy
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRetryShoot(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+		Expect(s.instance.Status.RetryAttempts).To(Equal(1))
+		Expect(s.instance.Status.LastRetryTime).ToNot(BeNil())
+
+		var patchedShoot gardener.Shoot
+		Expect(fsm.ShootClient.Get(ctx, client.ObjectKeyFromObject(shoot), &patchedShoot)).To(Succeed())
+		Expect(patchedShoot.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationRetry))
+	})
+
+	It("should reset the attempts and remove the retry annotation", func() {
+		// given
+		ctx := context.Background()
+		runtime := failedRuntime(map[string]string{reconciler.RetryAnnotation: "true"}, time.Hour)
+		runtime.Status.RetryAttempts = 2
+		shoot := failedShoot(gardener.ErrorInfraUnauthorized)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRetryShoot(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.RetryAttempts).To(Equal(0))
+
+		var updatedRuntime imv1.Runtime
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(runtime), &updatedRuntime)).To(Succeed())
+		Expect(updatedRuntime.Annotations).ToNot(HaveKey(reconciler.RetryAnnotation))
+	})
+
+	DescribeTable("should select retry for failed runtime",
+		func(runtime *imv1.Runtime, shoot *gardener.Shoot, expectedState string) {
+			// given
+			fsm := setupFakeFSMForTest(testScheme, runtime)
+			fsm.RetryPolicy = retryPolicy
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, extender.ShootRuntimeGenerationAnnotation, "0")
+			s := &systemState{instance: *runtime, shoot: shoot, snapshot: runtime.Status}
+
+			// when
+			next, _, _ := sFnSelectShootProcessing(context.Background(), fsm, s)
+
+			// then
+			if expectedState == "" {
+				Expect(next).To(BeNil())
+				return
+			}
+			Expect(next).To(haveName(expectedState))
+		},
+		Entry("when the retry delay passed", failedRuntime(nil, time.Hour), failedShoot(gardener.ErrorInfraDependencies), "sFnRetryShoot"),
+		Entry("when the retry annotation is set", failedRuntime(map[string]string{reconciler.RetryAnnotation: "true"}, time.Minute), failedShoot(gardener.ErrorInfraUnauthorized), "sFnRetryShoot"),
+		Entry("not when the retry delay did not pass", failedRuntime(nil, time.Minute), failedShoot(gardener.ErrorInfraDependencies), ""),
+		Entry("not when the error code is not eligible", failedRuntime(nil, time.Hour), failedShoot(gardener.ErrorInfraUnauthorized), ""),
+	)
+
+	It("should not retry when the attempts are exhausted", func() {
+		// given
+		runtime := failedRuntime(nil, time.Hour)
+		runtime.Status.RetryAttempts = 2
+		s := &systemState{instance: *runtime, shoot: failedShoot(gardener.ErrorInfraDependencies)}
+
+		// then
+		Expect(shouldRetryAutomatically(retryPolicy, s)).To(BeFalse())
+	})
+
+	It("should ignore failed shoot operation until the retry is started", func() {
+		// given
+		runtime := failedRuntime(nil, time.Hour)
+		runtime.Status.State = imv1.RuntimeStatePending
+		runtime.Status.LastRetryTime = &metav1.Time{Time: time.Now()}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.RequeueDurationShootReconcile = time.Minute
+		s := &systemState{instance: *runtime, shoot: failedShoot(gardener.ErrorInfraDependencies)}
+
+		// when
+		next, result, _ := sFnWaitForShootReconcile(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+	})
+
+	It("should reset the attempts when the retried shoot operation succeeded", func() {
+		// given
+		runtime := failedRuntime(nil, time.Hour)
+		runtime.Status.State = imv1.RuntimeStatePending
+		runtime.Status.RetryAttempts = 1
+		runtime.Status.LastRetryTime = &metav1.Time{Time: time.Now().Add(-time.Hour)}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		shoot := failedShoot(gardener.ErrorInfraDependencies)
+		shoot.Status.LastOperation.State = gardener.LastOperationStateSucceeded
+		shoot.Status.LastOperation.LastUpdateTime = metav1.Now()
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnWaitForShootReconcile(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.RetryAttempts).To(BeZero())
+		Expect(s.instance.Status.LastRetryTime).To(BeNil())
+	})
+})
@@ -0,0 +1,360 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig"
+	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// credentialsRotationCheckInterval is the interval of checking the progress of the started rotation
+	credentialsRotationCheckInterval = 5 * time.Minute
+	// credentialsRotationScheduleCheckInterval limits the time until the next check of the scheduled rotation
+	credentialsRotationScheduleCheckInterval = time.Hour
+	credentialsRotationRateLimitedDelay      = time.Minute
+)
+
+// allCredentials are rotated when no credentials are configured, in the order of the rotation
+var allCredentials = []config.CredentialsType{ //nolint:gochecknoglobals
+	config.CredentialsCertificateAuthorities,
+	config.CredentialsServiceAccountKey,
+	config.CredentialsETCDEncryptionKey,
+	config.CredentialsObservability,
+}
+
+// sFnRotateCredentials drives the Gardener credentials rotation of the Ready runtime, one rotation operation at a time.
+// The rotation phases are taken from the shoot status, the kubeconfig rotation is forced after every change of the certificate authorities
+// and the certificate authorities rotation is completed only after the kubeconfig was rotated.
+func sFnRotateCredentials(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	rotationRequested := reconciler.ShouldRotateCredentials(s.instance.Annotations)
+	if rotationRequested {
+		m.log.Info("Rotate credentials annotation found, removing the annotation and rotating the credentials", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		annotations := s.instance.Annotations
+		delete(annotations, reconciler.RotateCredentialsAnnotation)
+		s.instance.SetAnnotations(annotations)
+
+		if err := m.Update(ctx, &s.instance); err != nil {
+			m.log.Error(err, "Failed to remove rotate credentials annotation, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	// the status is set after the update, which overwrites the instance with the stored object
+	if s.instance.Status.CredentialsRotation == nil {
+		s.instance.Status.CredentialsRotation = &imv1.CredentialsRotationStatus{}
+	}
+	rotationStatus := s.instance.Status.CredentialsRotation
+	if rotationRequested {
+		rotationStatus.RequestTime = &metav1.Time{Time: time.Now()}
+	}
+
+	if needsKubeconfigRefresh(s) {
+		if err := forceKubeconfigRotation(ctx, m, s); err != nil {
+			m.log.Error(err, "Failed to force kubeconfig rotation after certificate authorities change, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+		m.log.Info("Kubeconfig rotation forced after certificate authorities change", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		rotationStatus.KubeconfigRefreshTime = &metav1.Time{Time: time.Now()}
+	}
+
+	operation := nextRotationOperation(m.CredentialsRotation, s)
+	if operation == "" {
+		if !reflect.DeepEqual(s.instance.Status, s.snapshot) {
+			return updateStatusAndStop()
+		}
+		return stop()
+	}
+
+	if operation == v1beta1constants.OperationRotateCAComplete {
+		pending, err := isKubeconfigRotationPending(ctx, m, s)
+		if err != nil {
+			m.log.Error(err, "Failed to check kubeconfig rotation, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+		if pending {
+			m.log.Info("Waiting for kubeconfig rotation before completing certificate authorities rotation", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+			return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	if m.RotationRateLimiter != nil && isScheduledRotationStart(m.CredentialsRotation, s, operation) && !m.RotationRateLimiter.Allow() {
+		m.log.V(log_level.DEBUG).Info("Credentials rotation rate limit exceeded, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return updateStatusAndRequeueAfter(withJitter(credentialsRotationRateLimitedDelay, 1))
+	}
+
+	original := s.shoot.DeepCopy()
+	metav1.SetMetaDataAnnotation(&s.shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+	if err := m.ShootClient.Patch(ctx, s.shoot, client.MergeFrom(original)); err != nil {
+		m.log.Error(err, "Failed to request credentials rotation operation for shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	rotationStatus.LastOperation = operation
+	rotationStatus.LastOperationTime = &metav1.Time{Time: time.Now()}
+
+	msg := fmt.Sprintf("Credentials rotation operation %s requested", operation)
+	m.log.Info(msg, "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonCredentialsRotationRequested,
+		"Unknown",
+		msg)
+
+	return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
+}
+
+// shouldRotateCredentials returns true when a rotation operation or the kubeconfig rotation has to be requested for the shoot
+func shouldRotateCredentials(cfg config.CredentialsRotation, s *systemState) bool {
+	if _, found := s.shoot.Annotations[v1beta1constants.GardenerOperation]; found || s.shoot.Status.IsHibernated {
+		return false
+	}
+
+	return reconciler.ShouldRotateCredentials(s.instance.Annotations) || needsKubeconfigRefresh(s) || nextRotationOperation(cfg, s) != ""
+}
+
+// nextRotationOperation returns the Gardener operation continuing the rotation, nothing is returned while any rotation is in progress.
+// The rotations prepared by the Runtime Controller are completed before the next rotation is started.
+func nextRotationOperation(cfg config.CredentialsRotation, s *systemState) string {
+	rotations := credentialsRotations(s.shoot, cfg.Credentials)
+
+	for _, r := range rotations {
+		if r.inProgress() {
+			return ""
+		}
+	}
+
+	for _, r := range rotations {
+		if r.preparedBy(s.instance.Status.CredentialsRotation) {
+			return r.completeOperation
+		}
+	}
+
+	for _, r := range rotations {
+		if isRotationDue(cfg, s, r) {
+			return r.startOperation
+		}
+	}
+
+	return ""
+}
+
+// nextCredentialsRotationCheck returns the time after which the Ready runtime is checked again for the started or scheduled rotations
+func nextCredentialsRotationCheck(cfg config.CredentialsRotation, s *systemState) time.Duration {
+	if s.shoot == nil || !s.instance.GetDeletionTimestamp().IsZero() || s.instance.Status.State != imv1.RuntimeStateReady {
+		return 0
+	}
+
+	rotations := credentialsRotations(s.shoot, cfg.Credentials)
+	for _, r := range rotations {
+		if r.inProgress() || r.preparedBy(s.instance.Status.CredentialsRotation) {
+			return credentialsRotationCheckInterval
+		}
+	}
+
+	if !cfg.Enabled || cfg.Interval.Duration <= 0 {
+		return 0
+	}
+
+	next := credentialsRotationScheduleCheckInterval
+	for _, r := range rotations {
+		next = min(next, time.Until(r.lastRotationTime(s.shoot).Add(cfg.Interval.Duration)))
+	}
+
+	return max(next, credentialsRotationCheckInterval)
+}
+
+// isRotationDue returns true when the rotation was requested with the annotation or the configured interval elapsed since the last rotation
+func isRotationDue(cfg config.CredentialsRotation, s *systemState, r credentialsRotation) bool {
+	// the rotation prepared outside of the Runtime Controller has to be completed first
+	if r.phase == gardener.RotationPrepared {
+		return false
+	}
+
+	if isRotationRequested(s, r) {
+		return true
+	}
+
+	if !cfg.Enabled || cfg.Interval.Duration <= 0 {
+		return false
+	}
+
+	return time.Since(r.lastRotationTime(s.shoot)) >= cfg.Interval.Duration
+}
+
+// isRotationRequested returns true when the rotation was requested with the annotation and was not completed since then
+func isRotationRequested(s *systemState, r credentialsRotation) bool {
+	rotationStatus := s.instance.Status.CredentialsRotation
+	return rotationStatus != nil && rotationStatus.RequestTime != nil && (r.lastCompletionTime == nil || r.lastCompletionTime.Before(rotationStatus.RequestTime))
+}
+
+// isScheduledRotationStart returns true when the operation starts the rotation due to the configured interval, such rotations are rate limited
+func isScheduledRotationStart(cfg config.CredentialsRotation, s *systemState, operation string) bool {
+	for _, r := range credentialsRotations(s.shoot, cfg.Credentials) {
+		if r.startOperation == operation {
+			return !isRotationRequested(s, r)
+		}
+	}
+
+	return false
+}
+
+// NewRotationRateLimiter creates the limiter shared by the scheduled credentials rotations of all runtimes
+func NewRotationRateLimiter(cfg config.CredentialsRotation) *rate.Limiter {
+	limiter := rate.NewLimiter(rate.Inf, 1)
+	SetRotationRateLimit(limiter, cfg)
+	return limiter
+}
+
+// SetRotationRateLimit applies the configured number of rotations per minute, the rotations are not limited when it is not set
+func SetRotationRateLimit(limiter *rate.Limiter, cfg config.CredentialsRotation) {
+	setPerMinuteLimit(limiter, cfg.RotationsPerMinute)
+}
+
+// needsKubeconfigRefresh returns true when the certificate authorities changed after the kubeconfig rotation forced for the rotation driven by the Runtime Controller
+func needsKubeconfigRefresh(s *systemState) bool {
+	rotationStatus := s.instance.Status.CredentialsRotation
+	if rotationStatus == nil || (rotationStatus.LastOperation != v1beta1constants.OperationRotateCAStart && rotationStatus.LastOperation != v1beta1constants.OperationRotateCAComplete) {
+		return false
+	}
+
+	if s.shoot.Status.Credentials == nil || s.shoot.Status.Credentials.Rotation == nil || s.shoot.Status.Credentials.Rotation.CertificateAuthorities == nil {
+		return false
+	}
+
+	caRotation := s.shoot.Status.Credentials.Rotation.CertificateAuthorities
+	changed := caRotation.LastInitiationFinishedTime
+	if caRotation.LastCompletionTime != nil && (changed == nil || changed.Before(caRotation.LastCompletionTime)) {
+		changed = caRotation.LastCompletionTime
+	}
+
+	return changed != nil && (rotationStatus.KubeconfigRefreshTime == nil || rotationStatus.KubeconfigRefreshTime.Before(changed))
+}
+
+func forceKubeconfigRotation(ctx context.Context, m *fsm, s *systemState) error {
+	var cluster imv1.GardenerCluster
+	if err := getGardenerCluster(ctx, m, s, &cluster); err != nil {
+		return err
+	}
+
+	original := cluster.DeepCopy()
+	metav1.SetMetaDataAnnotation(&cluster.ObjectMeta, kubeconfig.ForceKubeconfigRotationAnnotation, "true")
+
+	return m.Patch(ctx, &cluster, client.MergeFrom(original))
+}
+
+// isKubeconfigRotationPending returns true until the GardenerCluster controller rotated the kubeconfig and removed the force rotation annotation
+func isKubeconfigRotationPending(ctx context.Context, m *fsm, s *systemState) (bool, error) {
+	var cluster imv1.GardenerCluster
+	if err := getGardenerCluster(ctx, m, s, &cluster); err != nil {
+		return false, err
+	}
+
+	_, found := cluster.Annotations[kubeconfig.ForceKubeconfigRotationAnnotation]
+	return found, nil
+}
+
+func getGardenerCluster(ctx context.Context, m *fsm, s *systemState, cluster *imv1.GardenerCluster) error {
+	return m.Get(ctx, types.NamespacedName{Name: s.instance.Labels[imv1.LabelKymaRuntimeID], Namespace: s.instance.Namespace}, cluster)
+}
+
+// credentialsRotation describes the rotation of a single shoot credential, the completeOperation is empty for the single phase rotation
+type credentialsRotation struct {
+	phase              gardener.CredentialsRotationPhase
+	lastInitiationTime *metav1.Time
+	lastCompletionTime *metav1.Time
+	startOperation     string
+	completeOperation  string
+}
+
+func (r credentialsRotation) inProgress() bool {
+	if r.completeOperation == "" {
+		return r.lastInitiationTime != nil && (r.lastCompletionTime == nil || r.lastCompletionTime.Before(r.lastInitiationTime))
+	}
+
+	switch r.phase {
+	case gardener.RotationPreparing, gardener.RotationPreparingWithoutWorkersRollout, gardener.RotationWaitingForWorkersRollout, gardener.RotationCompleting:
+		return true
+	default:
+		return false
+	}
+}
+
+// preparedBy returns true when the first phase of the rotation started by the Runtime Controller finished
+func (r credentialsRotation) preparedBy(rotationStatus *imv1.CredentialsRotationStatus) bool {
+	return r.phase == gardener.RotationPrepared && rotationStatus != nil && rotationStatus.LastOperation == r.startOperation
+}
+
+// lastRotationTime returns the time of the last completed rotation, the shoot creation time is used for the never rotated credentials
+func (r credentialsRotation) lastRotationTime(shoot *gardener.Shoot) time.Time {
+	if r.lastCompletionTime != nil {
+		return r.lastCompletionTime.Time
+	}
+
+	return shoot.CreationTimestamp.Time
+}
+
+func credentialsRotations(shoot *gardener.Shoot, credentials []config.CredentialsType) []credentialsRotation {
+	if len(credentials) == 0 {
+		credentials = allCredentials
+	}
+
+	status := &gardener.ShootCredentialsRotation{}
+	if shoot.Status.Credentials != nil && shoot.Status.Credentials.Rotation != nil {
+		status = shoot.Status.Credentials.Rotation
+	}
+
+	rotations := make([]credentialsRotation, 0, len(credentials))
+	for _, credentialsType := range credentials {
+		var r credentialsRotation
+
+		switch credentialsType {
+		case config.CredentialsCertificateAuthorities:
+			r = credentialsRotation{startOperation: v1beta1constants.OperationRotateCAStart, completeOperation: v1beta1constants.OperationRotateCAComplete}
+			if status.CertificateAuthorities != nil {
+				r.phase = status.CertificateAuthorities.Phase
+				r.lastInitiationTime = status.CertificateAuthorities.LastInitiationTime
+				r.lastCompletionTime = status.CertificateAuthorities.LastCompletionTime
+			}
+		case config.CredentialsServiceAccountKey:
+			r = credentialsRotation{startOperation: v1beta1constants.OperationRotateServiceAccountKeyStart, completeOperation: v1beta1constants.OperationRotateServiceAccountKeyComplete}
+			if status.ServiceAccountKey != nil {
+				r.phase = status.ServiceAccountKey.Phase
+				r.lastInitiationTime = status.ServiceAccountKey.LastInitiationTime
+				r.lastCompletionTime = status.ServiceAccountKey.LastCompletionTime
+			}
+		case config.CredentialsETCDEncryptionKey:
+			r = credentialsRotation{startOperation: v1beta1constants.OperationRotateETCDEncryptionKeyStart, completeOperation: v1beta1constants.OperationRotateETCDEncryptionKeyComplete}
+			if status.ETCDEncryptionKey != nil {
+				r.phase = status.ETCDEncryptionKey.Phase
+				r.lastInitiationTime = status.ETCDEncryptionKey.LastInitiationTime
+				r.lastCompletionTime = status.ETCDEncryptionKey.LastCompletionTime
+			}
+		case config.CredentialsObservability:
+			r = credentialsRotation{startOperation: v1beta1constants.OperationRotateObservabilityCredentials}
+			if status.Observability != nil {
+				r.lastInitiationTime = status.Observability.LastInitiationTime
+				r.lastCompletionTime = status.Observability.LastCompletionTime
+			}
+		default:
+			continue
+		}
+
+		rotations = append(rotations, r)
+	}
+
+	return rotations
+}
@@ -0,0 +1,277 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM sFnRotateCredentials", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	readyShoot := func(rotation *gardener.ShootCredentialsRotation) *gardener.Shoot {
+		shoot := fsm_testing.TestShootForPatch()
+		shoot.Namespace = "garden-"
+		shoot.CreationTimestamp = metav1.NewTime(time.Now().Add(-48 * time.Hour))
+		shoot.Status.LastOperation = &gardener.LastOperation{
+			Type:  gardener.LastOperationTypeReconcile,
+			State: gardener.LastOperationStateSucceeded,
+		}
+		shoot.Status.Credentials = &gardener.ShootCredentials{Rotation: rotation}
+		return shoot
+	}
+
+	readyRuntime := func(annotations map[string]string, rotationStatus *imv1.CredentialsRotationStatus) *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(annotations)
+		runtime.Status.State = imv1.RuntimeStateReady
+		runtime.Status.CredentialsRotation = rotationStatus
+		return runtime
+	}
+
+	getShootOperation := func(ctx context.Context, fsm *fsm, shoot *gardener.Shoot) string {
+		var patchedShoot gardener.Shoot
+		Expect(fsm.ShootClient.Get(ctx, client.ObjectKeyFromObject(shoot), &patchedShoot)).To(Succeed())
+		return patchedShoot.Annotations[v1beta1constants.GardenerOperation]
+	}
+
+	It("should start the requested rotation and remove the annotation", func() {
+		// given
+		ctx := context.Background()
+		runtime := readyRuntime(map[string]string{reconciler.RotateCredentialsAnnotation: "true"}, nil)
+		shoot := readyShoot(nil)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+		Expect(s.instance.Status.CredentialsRotation.RequestTime).ToNot(BeNil())
+		Expect(s.instance.Status.CredentialsRotation.LastOperation).To(Equal(v1beta1constants.OperationRotateCAStart))
+		Expect(getShootOperation(ctx, fsm, shoot)).To(Equal(v1beta1constants.OperationRotateCAStart))
+
+		var updatedRuntime imv1.Runtime
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(runtime), &updatedRuntime)).To(Succeed())
+		Expect(updatedRuntime.Annotations).ToNot(HaveKey(reconciler.RotateCredentialsAnnotation))
+	})
+
+	It("should refresh the kubeconfig and wait for its rotation before completing the certificate authorities rotation", func() {
+		// given
+		ctx := context.Background()
+		runtime := readyRuntime(nil, &imv1.CredentialsRotationStatus{
+			RequestTime:   &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			LastOperation: v1beta1constants.OperationRotateCAStart,
+		})
+		shoot := readyShoot(&gardener.ShootCredentialsRotation{
+			CertificateAuthorities: &gardener.CARotation{
+				Phase:                      gardener.RotationPrepared,
+				LastInitiationFinishedTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		})
+		cluster := &imv1.GardenerCluster{ObjectMeta: metav1.ObjectMeta{Name: "runtime-id", Namespace: "kcp-system"}}
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot, cluster)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.CredentialsRotation.KubeconfigRefreshTime).ToNot(BeNil())
+		Expect(s.instance.Status.CredentialsRotation.LastOperation).To(Equal(v1beta1constants.OperationRotateCAStart))
+		Expect(getShootOperation(ctx, fsm, shoot)).To(BeEmpty())
+
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+		Expect(cluster.Annotations).To(HaveKeyWithValue(kubeconfig.ForceKubeconfigRotationAnnotation, "true"))
+	})
+
+	It("should complete the prepared certificate authorities rotation after the kubeconfig was rotated", func() {
+		// given
+		ctx := context.Background()
+		runtime := readyRuntime(nil, &imv1.CredentialsRotationStatus{
+			RequestTime:           &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			LastOperation:         v1beta1constants.OperationRotateCAStart,
+			KubeconfigRefreshTime: &metav1.Time{Time: time.Now()},
+		})
+		shoot := readyShoot(&gardener.ShootCredentialsRotation{
+			CertificateAuthorities: &gardener.CARotation{
+				Phase:                      gardener.RotationPrepared,
+				LastInitiationFinishedTime: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+			},
+		})
+		cluster := &imv1.GardenerCluster{ObjectMeta: metav1.ObjectMeta{Name: "runtime-id", Namespace: "kcp-system"}}
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot, cluster)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.CredentialsRotation.LastOperation).To(Equal(v1beta1constants.OperationRotateCAComplete))
+		Expect(getShootOperation(ctx, fsm, shoot)).To(Equal(v1beta1constants.OperationRotateCAComplete))
+	})
+
+	It("should continue with the next requested credentials after the certificate authorities rotation completed", func() {
+		// given
+		ctx := context.Background()
+		requestTime := time.Now().Add(-time.Hour)
+		runtime := readyRuntime(nil, &imv1.CredentialsRotationStatus{
+			RequestTime:           &metav1.Time{Time: requestTime},
+			LastOperation:         v1beta1constants.OperationRotateCAComplete,
+			KubeconfigRefreshTime: &metav1.Time{Time: time.Now()},
+		})
+		shoot := readyShoot(&gardener.ShootCredentialsRotation{
+			CertificateAuthorities: &gardener.CARotation{
+				Phase:              gardener.RotationCompleted,
+				LastCompletionTime: &metav1.Time{Time: requestTime.Add(30 * time.Minute)},
+			},
+		})
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(getShootOperation(ctx, fsm, shoot)).To(Equal(v1beta1constants.OperationRotateServiceAccountKeyStart))
+	})
+
+	It("should wait while a rotation is in progress", func() {
+		// given
+		runtime := readyRuntime(nil, &imv1.CredentialsRotationStatus{
+			RequestTime:   &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			LastOperation: v1beta1constants.OperationRotateCAStart,
+		})
+		shoot := readyShoot(&gardener.ShootCredentialsRotation{
+			CertificateAuthorities: &gardener.CARotation{Phase: gardener.RotationWaitingForWorkersRollout},
+		})
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		s := &systemState{instance: *runtime, shoot: shoot, snapshot: runtime.Status}
+
+		// then
+		Expect(shouldRotateCredentials(fsm.CredentialsRotation, s)).To(BeFalse())
+		Expect(nextCredentialsRotationCheck(fsm.CredentialsRotation, s)).To(Equal(credentialsRotationCheckInterval))
+	})
+
+	It("should start the scheduled rotation of the configured credentials", func() {
+		// given
+		ctx := context.Background()
+		runtime := readyRuntime(nil, nil)
+		shoot := readyShoot(&gardener.ShootCredentialsRotation{
+			Observability: &gardener.ObservabilityRotation{
+				LastInitiationTime: &metav1.Time{Time: time.Now().Add(-49 * time.Hour)},
+				LastCompletionTime: &metav1.Time{Time: time.Now().Add(-48 * time.Hour)},
+			},
+		})
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.CredentialsRotation = config.CredentialsRotation{
+			Enabled:     true,
+			Interval:    metav1.Duration{Duration: 24 * time.Hour},
+			Credentials: []config.CredentialsType{config.CredentialsObservability},
+		}
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(getShootOperation(ctx, fsm, shoot)).To(Equal(v1beta1constants.OperationRotateObservabilityCredentials))
+	})
+
+	It("should not start the scheduled rotation when the rate limit is exceeded", func() {
+		// given
+		ctx := context.Background()
+		runtime := readyRuntime(nil, nil)
+		shoot := readyShoot(nil)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.CredentialsRotation = config.CredentialsRotation{
+			Enabled:            true,
+			Interval:           metav1.Duration{Duration: 24 * time.Hour},
+			Credentials:        []config.CredentialsType{config.CredentialsObservability},
+			RotationsPerMinute: 1,
+		}
+		fsm.RotationRateLimiter = NewRotationRateLimiter(fsm.CredentialsRotation)
+		Expect(fsm.RotationRateLimiter.Allow()).To(BeTrue())
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(getShootOperation(ctx, fsm, shoot)).To(BeEmpty())
+		Expect(s.instance.Status.CredentialsRotation.LastOperation).To(BeEmpty())
+	})
+
+	It("should not limit the rotation requested with the annotation", func() {
+		// given
+		ctx := context.Background()
+		runtime := readyRuntime(map[string]string{reconciler.RotateCredentialsAnnotation: "true"}, nil)
+		shoot := readyShoot(nil)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.CredentialsRotation = config.CredentialsRotation{RotationsPerMinute: 1}
+		fsm.RotationRateLimiter = NewRotationRateLimiter(fsm.CredentialsRotation)
+		Expect(fsm.RotationRateLimiter.Allow()).To(BeTrue())
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		_, _, _ = sFnRotateCredentials(ctx, fsm, s)
+
+		// then
+		Expect(getShootOperation(ctx, fsm, shoot)).To(Equal(v1beta1constants.OperationRotateCAStart))
+	})
+
+	It("should not start the rotation before the interval elapsed", func() {
+		// given
+		runtime := readyRuntime(nil, nil)
+		shoot := readyShoot(nil)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.CredentialsRotation = config.CredentialsRotation{
+			Enabled:  true,
+			Interval: metav1.Duration{Duration: 72 * time.Hour},
+		}
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// then
+		Expect(shouldRotateCredentials(fsm.CredentialsRotation, s)).To(BeFalse())
+		Expect(nextCredentialsRotationCheck(fsm.CredentialsRotation, s)).To(Equal(credentialsRotationScheduleCheckInterval))
+	})
+
+	It("should select the credentials rotation for Ready runtime with the annotation", func() {
+		// given
+		runtime := readyRuntime(map[string]string{reconciler.RotateCredentialsAnnotation: "true"}, nil)
+		shoot := readyShoot(nil)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, extender.ShootRuntimeGenerationAnnotation, "0")
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime, shoot: shoot, snapshot: runtime.Status}
+
+		// when
+		next, _, _ := sFnSelectShootProcessing(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnRotateCredentials"))
+	})
+})
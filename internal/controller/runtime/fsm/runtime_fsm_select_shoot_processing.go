@@ -3,6 +3,7 @@ package fsm
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -11,10 +12,17 @@ import (
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
 	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
 	reconciler "github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"k8s.io/apimachinery/pkg/api/meta"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	// the expired temporary access is revoked in every state, the API server of the hibernated shoot is not reachable
+	if !s.shoot.Status.IsHibernated && hasExpiredTemporaryAdministrators(s.instance) {
+		m.log.Info("Temporary administrator access expired, revoking the access", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return switchState(sFnRevokeTemporaryAdministrators)
+	}
+
 	if s.shoot.Spec.DNS == nil || s.shoot.Spec.DNS.Domain == nil {
 		m.log.V(log_level.DEBUG).Info("DNS Domain is not set yet for shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
 		m.Metrics.SetRuntimeStates(s.instance)
@@ -28,6 +36,10 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 		return requeueAfter(m.GardenerRequeueDuration)
 	}
 
+	if s.instance.Status.State == imv1.RuntimeStateFailed && reconciler.ShouldRetry(s.instance.Annotations) {
+		return switchState(sFnRetryShoot)
+	}
+
 	patchShoot, err := shouldPatchShoot(&s.instance, s.shoot, &m.log)
 	if err != nil {
 		m.log.Error(err, "Failed to get applied generation for shoot", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
@@ -39,6 +51,16 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 		return switchState(sFnPatchExistingShoot)
 	}
 
+	if lastOperation.State == gardener.LastOperationStateSucceeded && isHibernationChanged(s) {
+		if s.shoot.Status.IsHibernated {
+			return switchState(sFnShootHibernated)
+		}
+
+		m.log.Info("Shoot woken up, continuing runtime configuration", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		meta.RemoveStatusCondition(&s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeHibernated))
+		return switchState(sFnHandleKubeconfig)
+	}
+
 	if s.instance.Status.State == imv1.RuntimeStatePending || s.instance.Status.State == "" {
 		if lastOperation.Type == gardener.LastOperationTypeCreate {
 			return switchState(sFnWaitForShootCreation)
@@ -49,8 +71,28 @@ func sFnSelectShootProcessing(_ context.Context, m *fsm, s *systemState) (stateF
 		}
 	}
 
+	if s.instance.Status.State == imv1.RuntimeStateFailed && lastOperation.State == gardener.LastOperationStateFailed && shouldRetryAutomatically(m.RetryPolicy, s) {
+		if delay := retryDelay(m.RetryPolicy, s); delay > 0 {
+			m.log.V(log_level.DEBUG).Info("Automatic retry of the failed shoot operation scheduled", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "retryAfter", delay)
+			return requeueAfter(delay)
+		}
+		return switchState(sFnRetryShoot)
+	}
+
+	if s.instance.Status.State == imv1.RuntimeStateReady && lastOperation.State == gardener.LastOperationStateSucceeded && shouldRotateCredentials(m.CredentialsRotation, s) {
+		return switchState(sFnRotateCredentials)
+	}
+
+	if m.DriftDetection.Enabled && (s.instance.Status.State == imv1.RuntimeStateReady || s.instance.Status.State == imv1.RuntimeStateFailed) {
+		return switchState(sFnDetectDrift)
+	}
+
 	// All other runtimes in Ready and Failed state will be not processed to mitigate massive reconciliation during restart
 	m.log.Info("Stopping processing reconcile, exiting with no retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name, "function", "sFnSelectShootProcessing")
+	if !reflect.DeepEqual(s.instance.Status, s.snapshot) {
+		// make sure the observed shoot details are stored
+		return updateStatusAndStop()
+	}
 	return stop()
 }
 
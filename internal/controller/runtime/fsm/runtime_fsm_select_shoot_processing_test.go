@@ -32,6 +32,15 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 
 	inputRtWithForceAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"})
 	inputRtWithSuspendAnnotation := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/suspend-patch-reconciliation": "true"})
+	inputRtWithObservedShoot := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/suspend-patch-reconciliation": "true"})
+	inputRtWithObservedShoot.Status.Shoot = &imv1.ShootStatus{DNSDomain: ptr.To("test-domain")}
+	inputReadyRt := makeInputRuntimeWithAnnotation(map[string]string{"operator.kyma-project.io/suspend-patch-reconciliation": "true"})
+	inputReadyRt.Status.State = imv1.RuntimeStateReady
+
+	withDriftDetectionEnabled := func(fsm *fsm) error {
+		fsm.DriftDetection.Enabled = true
+		return nil
+	}
 
 	testShoot := gardener.Shoot{
 		ObjectMeta: metav1.ObjectMeta{
@@ -75,6 +84,26 @@ var _ = Describe("KIM sFnSelectShootProcessing", func() {
 				MatchNextFnState: BeNil(),
 			},
 		),
+		Entry(
+			"should update status when observed shoot details changed",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects()),
+			&systemState{instance: *inputRtWithObservedShoot, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnUpdateStatus"),
+			},
+		),
+		Entry(
+			"should switch to sFnDetectDrift for Ready runtime when drift detection is enabled",
+			testCtx,
+			must(newFakeFSM, withTestFinalizer, withTestSchemeAndObjects(), withDriftDetectionEnabled),
+			&systemState{instance: *inputReadyRt, shoot: &testShoot},
+			testOpts{
+				MatchExpectedErr: BeNil(),
+				MatchNextFnState: haveName("sFnDetectDrift"),
+			},
+		),
 	)
 })
 
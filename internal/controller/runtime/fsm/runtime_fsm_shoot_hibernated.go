@@ -0,0 +1,38 @@
+package fsm
+
+import (
+	"context"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// sFnShootHibernated stops processing of the hibernated shoot, as the runtime can't be configured until the shoot is woken up.
+// Only the provisioned runtime is Ready, the runtime without the kubeconfig stays Pending until the wake-up.
+func sFnShootHibernated(_ context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	m.log.Info("Shoot is hibernated, stopping processing until the wake-up", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	clearTimeoutCondition(&s.instance)
+
+	if s.instance.IsProvisioningCompletedStatusSet() {
+		s.instance.UpdateStateReady(
+			imv1.ConditionTypeRuntimeHibernated,
+			imv1.ConditionReasonShootHibernated,
+			"Shoot is hibernated, the runtime configuration is continued after the wake-up")
+		return updateStatusAndStop()
+	}
+
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeHibernated,
+		imv1.ConditionReasonShootHibernated,
+		"True",
+		"Shoot is hibernated before the provisioning completed, the runtime configuration is continued after the wake-up")
+
+	return updateStatusAndStop()
+}
+
+// isHibernationChanged returns true when the shoot was hibernated or woken up since the Hibernated condition was set
+func isHibernationChanged(s *systemState) bool {
+	hibernatedConditionSet := meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeHibernated)) != nil
+	return s.shoot.Status.IsHibernated != hibernatedConditionSet
+}
@@ -0,0 +1,135 @@
+package fsm
+
+import (
+	"context"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+)
+
+var _ = Describe("KIM sFnShootHibernated", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	reconciledShoot := func(hibernated bool) *gardener.Shoot {
+		shoot := fsm_testing.TestShootForPatch()
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, extender.ShootRuntimeGenerationAnnotation, "0")
+		shoot.Status.IsHibernated = hibernated
+		shoot.Status.LastOperation = &gardener.LastOperation{
+			Type:  gardener.LastOperationTypeReconcile,
+			State: gardener.LastOperationStateSucceeded,
+		}
+		return shoot
+	}
+
+	runtimeInState := func(state imv1.State, hibernatedCondition bool) *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = state
+		if hibernatedCondition {
+			runtime.Status.Conditions = []metav1.Condition{{
+				Type:   string(imv1.ConditionTypeRuntimeHibernated),
+				Status: metav1.ConditionTrue,
+				Reason: string(imv1.ConditionReasonShootHibernated),
+			}}
+		}
+		return runtime
+	}
+
+	It("should set Hibernated condition instead of waiting for the hibernated shoot", func() {
+		// given
+		runtime := runtimeInState(imv1.RuntimeStatePending, false)
+		runtime.Status.ProvisioningCompleted = true
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime, shoot: reconciledShoot(true)}
+
+		// when
+		next, _, _ := sFnWaitForShootReconcile(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnShootHibernated"))
+
+		// when
+		next, _, _ = sFnShootHibernated(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStateReady)))
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeRuntimeHibernated, imv1.ConditionReasonShootHibernated, metav1.ConditionTrue)).To(BeTrue())
+	})
+
+	It("should keep Runtime Pending when the shoot is hibernated before the provisioning completed", func() {
+		// given
+		runtime := runtimeInState(imv1.RuntimeStatePending, false)
+		runtime.Status.RetryAttempts = 1
+		runtime.Status.Conditions = []metav1.Condition{{
+			Type:   string(imv1.ConditionTypeProvisioningTimeout),
+			Status: metav1.ConditionTrue,
+			Reason: string(imv1.ConditionReasonShootCreationTimeout),
+		}}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		shoot := reconciledShoot(true)
+		shoot.Status.LastOperation.Type = gardener.LastOperationTypeCreate
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnWaitForShootCreation(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnShootHibernated"))
+		Expect(s.instance.Status.RetryAttempts).To(BeZero())
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeProvisioningTimeout))).To(BeNil())
+
+		// when
+		next, _, _ = sFnShootHibernated(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeRuntimeHibernated, imv1.ConditionReasonShootHibernated, metav1.ConditionTrue)).To(BeTrue())
+	})
+
+	DescribeTable("should select hibernation processing",
+		func(runtime *imv1.Runtime, shoot *gardener.Shoot, expectedState string) {
+			// given
+			fsm := setupFakeFSMForTest(testScheme, runtime)
+			s := &systemState{instance: *runtime, shoot: shoot, snapshot: runtime.Status}
+
+			// when
+			next, _, _ := sFnSelectShootProcessing(context.Background(), fsm, s)
+
+			// then
+			if expectedState == "" {
+				Expect(next).To(BeNil())
+				return
+			}
+			Expect(next).To(haveName(expectedState))
+		},
+		Entry("when the shoot of Ready runtime was hibernated", runtimeInState(imv1.RuntimeStateReady, false), reconciledShoot(true), "sFnShootHibernated"),
+		Entry("when the shoot was woken up", runtimeInState(imv1.RuntimeStateReady, true), reconciledShoot(false), "sFnHandleKubeconfig"),
+		Entry("not when the shoot is still hibernated", runtimeInState(imv1.RuntimeStateReady, true), reconciledShoot(true), ""),
+	)
+
+	It("should remove Hibernated condition when the shoot was woken up", func() {
+		// given
+		runtime := runtimeInState(imv1.RuntimeStateReady, true)
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime, shoot: reconciledShoot(false)}
+
+		// when
+		_, _, _ = sFnSelectShootProcessing(context.Background(), fsm, s)
+
+		// then
+		Expect(meta.FindStatusCondition(s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeHibernated))).To(BeNil())
+	})
+})
@@ -0,0 +1,134 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const eventReasonRuntimeRestored = "RuntimeRestored"
+
+// sFnSoftDeleteShoot hibernates the shoot of the Runtime with the requested deletion and deletes the Runtime after the recovery deadline.
+// Until then, the restore annotation removes the deletion request and the shoot is woken up.
+func sFnSoftDeleteShoot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	if reconciler.ShouldRestore(s.instance.Annotations) {
+		m.log.Info("Restore requested, removing the deletion request", "RuntimeCR", s.instance.Name)
+		annotations := s.instance.Annotations
+		delete(annotations, reconciler.DeletionRequestedAnnotation)
+		delete(annotations, reconciler.RestoreAnnotation)
+		s.instance.SetAnnotations(annotations)
+
+		if err := m.Update(ctx, &s.instance); err != nil {
+			m.log.Error(err, "Failed to remove deletion request, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	if !reconciler.IsDeletionRequested(s.instance.Annotations) {
+		return restoreRuntime(ctx, m, s)
+	}
+
+	if !m.SoftDelete.Enabled || s.shoot == nil || reconciler.ShouldOrphanShoot(s.instance.Annotations) {
+		return deleteRuntimeAndStop(ctx, m, s)
+	}
+
+	if deadline := s.instance.Status.RecoveryDeadline; deadline != nil {
+		if remaining := time.Until(deadline.Time); remaining > 0 {
+			return requeueAfter(remaining)
+		}
+
+		m.log.Info("Recovery deadline passed, deleting the Runtime", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return deleteRuntimeAndStop(ctx, m, s)
+	}
+
+	if err := setShootHibernation(ctx, m, s, true); err != nil {
+		m.log.Error(err, "Failed to hibernate shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	gracePeriod := m.SoftDelete.GracePeriod.Duration
+	s.instance.Status.RecoveryDeadline = &metav1.Time{Time: time.Now().Add(gracePeriod)}
+
+	msg := fmt.Sprintf("Shoot hibernated, Runtime can be restored until %s", s.instance.Status.RecoveryDeadline.UTC().Format(time.RFC3339))
+	m.log.Info(msg, "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+	s.instance.UpdateStateDeletion(
+		imv1.ConditionTypeRuntimeDeprovisioned,
+		imv1.ConditionReasonShootSoftDeleted,
+		"Unknown",
+		msg,
+	)
+
+	return updateStatusAndRequeueAfter(gracePeriod)
+}
+
+// restoreRuntime wakes up the shoot of the Runtime whose deletion request was removed, the runtime configuration is continued
+// after the shoot reconciliation
+func restoreRuntime(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	if s.shoot != nil && !isHibernationRequested(s.instance) {
+		if err := setShootHibernation(ctx, m, s, false); err != nil {
+			m.log.Error(err, "Failed to wake up shoot, scheduling for retry", "RuntimeCR", s.instance.Name, "shoot", s.shoot.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	m.log.Info("Runtime restored", "RuntimeCR", s.instance.Name)
+	m.Event(&s.instance, "Normal", eventReasonRuntimeRestored, fmt.Sprintf("Runtime restored, deletion request removed: %s/%s", s.instance.Namespace, s.instance.Name))
+
+	s.instance.Status.RecoveryDeadline = nil
+	meta.RemoveStatusCondition(&s.instance.Status.Conditions, string(imv1.ConditionTypeRuntimeDeprovisioned))
+	s.instance.UpdateStatePending(
+		imv1.ConditionTypeRuntimeProvisioned,
+		imv1.ConditionReasonRuntimeRestored,
+		"Unknown",
+		"Runtime restored, waiting for the shoot to wake up",
+	)
+
+	return updateStatusAndRequeue()
+}
+
+func deleteRuntimeAndStop(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	if err := m.Delete(ctx, &s.instance); client.IgnoreNotFound(err) != nil {
+		m.log.Error(err, "Failed to delete Runtime, scheduling for retry", "RuntimeCR", s.instance.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	m.log.Info("Runtime deleted, the shoot is removed with the deletion flow", "RuntimeCR", s.instance.Name)
+	return stop()
+}
+
+// deleteOrRequestDeletion requests the soft delete of the Runtime when it is enabled, otherwise the Runtime is deleted
+func deleteOrRequestDeletion(ctx context.Context, m *fsm, s *systemState) error {
+	if !m.SoftDelete.Enabled {
+		return m.Delete(ctx, &s.instance)
+	}
+
+	metav1.SetMetaDataAnnotation(&s.instance.ObjectMeta, reconciler.DeletionRequestedAnnotation, "true")
+	return m.Update(ctx, &s.instance)
+}
+
+func isHibernationRequested(runtime imv1.Runtime) bool {
+	return runtime.Spec.Shoot.Hibernation != nil && ptr.Deref(runtime.Spec.Shoot.Hibernation.Enabled, false)
+}
+
+func setShootHibernation(ctx context.Context, m *fsm, s *systemState, enabled bool) error {
+	if s.shoot.Spec.Hibernation != nil && ptr.Deref(s.shoot.Spec.Hibernation.Enabled, false) == enabled {
+		return nil
+	}
+
+	original := s.shoot.DeepCopy()
+	if s.shoot.Spec.Hibernation == nil {
+		s.shoot.Spec.Hibernation = &gardener.Hibernation{}
+	}
+	s.shoot.Spec.Hibernation.Enabled = ptr.To(enabled)
+
+	return m.ShootClient.Patch(ctx, s.shoot, client.MergeFrom(original))
+}
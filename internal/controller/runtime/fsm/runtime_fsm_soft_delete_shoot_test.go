@@ -0,0 +1,178 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM sFnSoftDeleteShoot", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+	util.Must(v1.AddToScheme(testScheme))
+
+	softDelete := config.SoftDelete{Enabled: true, GracePeriod: metav1.Duration{Duration: 24 * time.Hour}}
+
+	deletionRequestedRuntime := func(annotations map[string]string) *imv1.Runtime {
+		runtime := makeInputRuntimeWithAnnotation(annotations)
+		runtime.Finalizers = []string{"test-me-plz"}
+		metav1.SetMetaDataAnnotation(&runtime.ObjectMeta, reconciler.DeletionRequestedAnnotation, "true")
+		return runtime
+	}
+
+	testShoot := func(hibernated bool) *gardener.Shoot {
+		shoot := fsm_testing.TestShootForPatch()
+		shoot.Namespace = "garden-"
+		shoot.Spec.Hibernation = &gardener.Hibernation{Enabled: ptr.To(hibernated)}
+		return shoot
+	}
+
+	getShoot := func(fsm *fsm, shoot *gardener.Shoot) gardener.Shoot {
+		var actualShoot gardener.Shoot
+		Expect(fsm.ShootClient.Get(context.Background(), client.ObjectKeyFromObject(shoot), &actualShoot)).To(Succeed())
+		return actualShoot
+	}
+
+	getRuntime := func(fsm *fsm, runtime *imv1.Runtime) imv1.Runtime {
+		var actualRuntime imv1.Runtime
+		Expect(fsm.Get(context.Background(), client.ObjectKeyFromObject(runtime), &actualRuntime)).To(Succeed())
+		return actualRuntime
+	}
+
+	It("should switch to sFnSoftDeleteShoot when the deletion is requested", func() {
+		// given
+		runtime := deletionRequestedRuntime(nil)
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.SoftDelete = softDelete
+		s := &systemState{instance: *runtime, shoot: testShoot(false)}
+
+		// when
+		next, _, _ := sFnInitialize(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnSoftDeleteShoot"))
+	})
+
+	It("should delete the shoot of the deleted Runtime without the soft delete", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Finalizers = []string{"test-me-plz"}
+		runtime.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.SoftDelete = softDelete
+		s := &systemState{instance: *runtime, shoot: testShoot(false)}
+
+		// when
+		next, _, _ := sFnInitialize(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnDeleteKubeconfig"))
+	})
+
+	It("should hibernate shoot and set recovery deadline without deleting the Runtime", func() {
+		// given
+		runtime := deletionRequestedRuntime(nil)
+		shoot := testShoot(false)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.SoftDelete = softDelete
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnSoftDeleteShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStateTerminating)))
+		Expect(s.instance.Status.RecoveryDeadline).ToNot(BeNil())
+		Expect(s.instance.Status.RecoveryDeadline.Time).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeRuntimeDeprovisioned, imv1.ConditionReasonShootSoftDeleted, metav1.ConditionUnknown)).To(BeTrue())
+		Expect(*getShoot(fsm, shoot).Spec.Hibernation.Enabled).To(BeTrue())
+		Expect(getRuntime(fsm, runtime).DeletionTimestamp).To(BeNil())
+	})
+
+	It("should wait until the recovery deadline", func() {
+		// given
+		runtime := deletionRequestedRuntime(nil)
+		runtime.Status.RecoveryDeadline = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.SoftDelete = softDelete
+		s := &systemState{instance: *runtime, shoot: testShoot(true)}
+
+		// when
+		next, result, _ := sFnSoftDeleteShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+		Expect(getRuntime(fsm, runtime).DeletionTimestamp).To(BeNil())
+	})
+
+	It("should delete Runtime after the recovery deadline", func() {
+		// given
+		runtime := deletionRequestedRuntime(nil)
+		runtime.Status.RecoveryDeadline = &metav1.Time{Time: time.Now().Add(-time.Minute)}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		fsm.SoftDelete = softDelete
+		s := &systemState{instance: *runtime, shoot: testShoot(true)}
+
+		// when
+		next, _, _ := sFnSoftDeleteShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+		Expect(getRuntime(fsm, runtime).DeletionTimestamp).ToNot(BeNil())
+	})
+
+	It("should delete Runtime at once when the soft delete is disabled", func() {
+		// given
+		runtime := deletionRequestedRuntime(nil)
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime, shoot: testShoot(false)}
+
+		// when
+		next, _, _ := sFnSoftDeleteShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(BeNil())
+		Expect(getRuntime(fsm, runtime).DeletionTimestamp).ToNot(BeNil())
+	})
+
+	It("should remove the deletion request and wake up shoot when the restore annotation is set", func() {
+		// given
+		runtime := deletionRequestedRuntime(map[string]string{reconciler.RestoreAnnotation: "true"})
+		runtime.Status.RecoveryDeadline = &metav1.Time{Time: time.Now().Add(time.Hour)}
+		shoot := testShoot(true)
+		fsm := setupFakeFSMForTest(testScheme, runtime, shoot)
+		fsm.SoftDelete = softDelete
+		s := &systemState{instance: *runtime, shoot: shoot}
+
+		// when
+		next, _, _ := sFnSoftDeleteShoot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStatePending)))
+		Expect(s.instance.Status.RecoveryDeadline).To(BeNil())
+		Expect(s.instance.IsConditionSetWithStatus(imv1.ConditionTypeRuntimeProvisioned, imv1.ConditionReasonRuntimeRestored, metav1.ConditionUnknown)).To(BeTrue())
+		Expect(*getShoot(fsm, shoot).Spec.Hibernation.Enabled).To(BeFalse())
+
+		restoredRuntime := getRuntime(fsm, runtime)
+		Expect(restoredRuntime.DeletionTimestamp).To(BeNil())
+		Expect(restoredRuntime.Annotations).ToNot(HaveKey(reconciler.DeletionRequestedAnnotation))
+		Expect(restoredRuntime.Annotations).ToNot(HaveKey(reconciler.RestoreAnnotation))
+	})
+})
@@ -4,11 +4,14 @@ import (
 	"context"
 
 	gardener_api "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+const lastOperationProgressStep = 10
+
 // to save the runtime status at the begining of the reconciliation
 func sFnTakeSnapshot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
 	s.saveRuntimeStatus()
@@ -24,9 +27,48 @@ func sFnTakeSnapshot(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctr
 		return updateStatusAndRequeueAfter(m.GardenerRequeueDuration)
 	}
 
+	s.instance.Status.Shoot = nil
 	if err == nil {
 		s.shoot = &shoot
+		s.instance.Status.Shoot = observedShootStatus(shoot)
+	}
+
+	// the patch plan is kept only while the dry-run mode is enabled, even when no patch is due
+	if !isDryRun(m, s) {
+		s.instance.Status.PatchPlan = nil
 	}
 
 	return switchState(sFnInitialize)
 }
+
+func observedShootStatus(shoot gardener_api.Shoot) *imv1.ShootStatus {
+	shootStatus := &imv1.ShootStatus{
+		ObservedGeneration: shoot.Status.ObservedGeneration,
+		SeedName:           shoot.Spec.SeedName,
+		KubernetesVersion:  shoot.Spec.Kubernetes.Version,
+		Hibernated:         shoot.Status.IsHibernated,
+	}
+
+	if shoot.Status.LastOperation != nil {
+		shootStatus.LastOperation = &imv1.ShootLastOperation{
+			Type:  shoot.Status.LastOperation.Type,
+			State: shoot.Status.LastOperation.State,
+			// the progress is rounded down, so the status is updated only once per step
+			Progress: shoot.Status.LastOperation.Progress - shoot.Status.LastOperation.Progress%lastOperationProgressStep,
+		}
+	}
+
+	if shoot.Spec.DNS != nil {
+		shootStatus.DNSDomain = shoot.Spec.DNS.Domain
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		shootStatus.Workers = append(shootStatus.Workers, imv1.WorkerStatus{
+			Name:         worker.Name,
+			MachineType:  worker.Machine.Type,
+			MachineImage: worker.Machine.Image,
+		})
+	}
+
+	return shootStatus
+}
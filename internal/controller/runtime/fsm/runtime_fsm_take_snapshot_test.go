@@ -0,0 +1,80 @@
+package fsm
+
+import (
+	"context"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+)
+
+var _ = Describe("KIM observedShootStatus", func() {
+	It("should copy observed shoot details", func() {
+		// given
+		machineImage := &gardener.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.1.0")}
+		lastOperation := &gardener.LastOperation{
+			Type:     gardener.LastOperationTypeReconcile,
+			State:    gardener.LastOperationStateProcessing,
+			Progress: 42,
+		}
+		shoot := gardener.Shoot{
+			Spec: gardener.ShootSpec{
+				SeedName:   ptr.To("aws-eu1"),
+				DNS:        &gardener.DNS{Domain: ptr.To("test.kyma.example.com")},
+				Kubernetes: gardener.Kubernetes{Version: "1.30.5"},
+				Provider: gardener.Provider{
+					Workers: []gardener.Worker{
+						{Name: "cpu-worker-0", Machine: gardener.Machine{Type: "m6i.large", Image: machineImage}},
+					},
+				},
+			},
+			Status: gardener.ShootStatus{
+				ObservedGeneration: 7,
+				LastOperation:      lastOperation,
+			},
+		}
+
+		// when
+		shootStatus := observedShootStatus(shoot)
+
+		// then
+		Expect(*shootStatus).To(Equal(imv1.ShootStatus{
+			ObservedGeneration: 7,
+			SeedName:           ptr.To("aws-eu1"),
+			DNSDomain:          ptr.To("test.kyma.example.com"),
+			KubernetesVersion:  "1.30.5",
+			Workers: []imv1.WorkerStatus{
+				{Name: "cpu-worker-0", MachineType: "m6i.large", MachineImage: machineImage},
+			},
+			LastOperation: &imv1.ShootLastOperation{
+				Type:     gardener.LastOperationTypeReconcile,
+				State:    gardener.LastOperationStateProcessing,
+				Progress: 40,
+			},
+		}))
+	})
+
+	It("should clear observed shoot details when the shoot is gone", func() {
+		// given
+		testScheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(testScheme))
+		util.Must(gardener.AddToScheme(testScheme))
+
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.Shoot = &imv1.ShootStatus{ObservedGeneration: 7, KubernetesVersion: "1.30.5"}
+		fsm := setupFakeFSMForTest(testScheme, runtime)
+		s := &systemState{instance: *runtime}
+
+		// when
+		next, _, _ := sFnTakeSnapshot(context.Background(), fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnInitialize"))
+		Expect(s.shoot).To(BeNil())
+		Expect(s.instance.Status.Shoot).To(BeNil())
+	})
+})
@@ -0,0 +1,188 @@
+package fsm
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	temporaryAdministratorLabel               = "operator.kyma-project.io/temporary-administrator"
+	temporaryAdministratorExpiresAtAnnotation = "operator.kyma-project.io/expires-at"
+	temporaryAdministratorReasonAnnotation    = "operator.kyma-project.io/reason"
+
+	eventReasonTemporaryAdministratorGranted = "TemporaryAdministratorGranted"
+	eventReasonTemporaryAdministratorRevoked = "TemporaryAdministratorRevoked"
+)
+
+// sFnRevokeTemporaryAdministrators revokes the expired temporary access whatever the state of the runtime is, and continues with the shoot processing
+func sFnRevokeTemporaryAdministrators(ctx context.Context, m *fsm, s *systemState) (stateFn, *ctrl.Result, error) {
+	checkTime := time.Now()
+
+	shootAdminClient, err := GetShootClient(ctx, m.Client, s.instance)
+	if err != nil && !apierrors.IsNotFound(err) {
+		m.log.Error(err, "Cannot get shoot client to revoke the expired temporary administrators, scheduling for retry", "RuntimeCR", s.instance.Name)
+		return requeueAfter(m.GardenerRequeueDuration)
+	}
+
+	var revoked []rbacv1.ClusterRoleBinding
+	// without the kubeconfig secret the temporary access has never been granted
+	if err == nil {
+		var crbList rbacv1.ClusterRoleBindingList
+		if err := shootAdminClient.List(ctx, &crbList); err != nil {
+			m.log.Error(err, "Cannot list Cluster Role Bindings on shoot, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+
+		revoked, _ = getTemporaryAdministratorChanges(crbList.Items, s.instance.Spec.Security.TemporaryAdministrators, checkTime)
+		if err := newDelCRBs(ctx, shootAdminClient, revoked)(); err != nil {
+			m.log.Error(err, "Cannot revoke the expired temporary administrators, scheduling for retry", "RuntimeCR", s.instance.Name)
+			return requeueAfter(m.GardenerRequeueDuration)
+		}
+	}
+
+	recordTemporaryAdministratorChanges(m, s, revoked, nil)
+	s.instance.Status.TemporaryAdministratorsCheckTime = &metav1.Time{Time: checkTime}
+
+	return switchState(sFnSelectShootProcessing)
+}
+
+func isTemporaryAdministratorBinding(crb rbacv1.ClusterRoleBinding) bool {
+	return managedByKIM(crb) && crb.Labels[temporaryAdministratorLabel] == "true"
+}
+
+// getTemporaryAdministratorChanges returns the cluster role bindings of the expired or removed temporary administrators, and of the temporary administrators without access
+func getTemporaryAdministratorChanges(crbs []rbacv1.ClusterRoleBinding, admins []imv1.TemporaryAdministrator, now time.Time) (revoked, granted []rbacv1.ClusterRoleBinding) {
+	var active []rbacv1.ClusterRoleBinding
+	for _, admin := range admins {
+		if admin.ExpiresAt.Time.After(now) {
+			active = append(active, toTemporaryAdminClusterRoleBinding(admin))
+		}
+	}
+
+	for _, crb := range crbs {
+		if !isTemporaryAdministratorBinding(crb) {
+			continue
+		}
+
+		if !slices.ContainsFunc(active, sameTemporaryAccess(crb)) {
+			revoked = append(revoked, crb)
+		}
+	}
+
+	for _, crb := range active {
+		if !slices.ContainsFunc(crbs, sameTemporaryAccess(crb)) {
+			granted = append(granted, crb)
+		}
+	}
+
+	return revoked, granted
+}
+
+// sameTemporaryAccess matches the temporary administrator cluster role bindings of the same subject and expiration time
+func sameTemporaryAccess(crb rbacv1.ClusterRoleBinding) func(rbacv1.ClusterRoleBinding) bool {
+	return func(other rbacv1.ClusterRoleBinding) bool {
+		return isTemporaryAdministratorBinding(other) &&
+			slices.Equal(crb.Subjects, other.Subjects) &&
+			crb.Annotations[temporaryAdministratorExpiresAtAnnotation] == other.Annotations[temporaryAdministratorExpiresAtAnnotation]
+	}
+}
+
+func toTemporaryAdminClusterRoleBinding(admin imv1.TemporaryAdministrator) rbacv1.ClusterRoleBinding {
+	kind := admin.Kind
+	if kind == "" {
+		kind = rbacv1.UserKind
+	}
+
+	labels := map[string]string{temporaryAdministratorLabel: "true"}
+	for key, value := range labelsManagedByKIM {
+		labels[key] = value
+	}
+
+	annotations := map[string]string{temporaryAdministratorExpiresAtAnnotation: admin.ExpiresAt.UTC().Format(time.RFC3339)}
+	if admin.Reason != "" {
+		annotations[temporaryAdministratorReasonAnnotation] = admin.Reason
+	}
+
+	return rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "temporary-admin-",
+			Labels:       labels,
+			Annotations:  annotations,
+		},
+		Subjects: []rbacv1.Subject{{
+			Kind:     kind,
+			Name:     admin.Name,
+			APIGroup: rbacv1.GroupName,
+		}},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "ClusterRole",
+			Name:     "cluster-admin",
+		},
+	}
+}
+
+// recordTemporaryAdministratorChanges emits the audit events for the granted and revoked temporary access
+func recordTemporaryAdministratorChanges(m *fsm, s *systemState, revoked, granted []rbacv1.ClusterRoleBinding) {
+	for _, crb := range revoked {
+		msg := fmt.Sprintf("Temporary cluster admin access of %s revoked, expiration time %s", describeTemporaryAccess(crb), crb.Annotations[temporaryAdministratorExpiresAtAnnotation])
+		m.log.Info(msg, "RuntimeCR", s.instance.Name, "clusterRoleBinding", crb.Name)
+		m.Event(&s.instance, "Normal", eventReasonTemporaryAdministratorRevoked, fmt.Sprintf("%s: %s/%s", msg, s.instance.Namespace, s.instance.Name))
+	}
+
+	for _, crb := range granted {
+		msg := fmt.Sprintf("Temporary cluster admin access granted to %s until %s", describeTemporaryAccess(crb), crb.Annotations[temporaryAdministratorExpiresAtAnnotation])
+		m.log.Info(msg, "RuntimeCR", s.instance.Name)
+		m.Event(&s.instance, "Normal", eventReasonTemporaryAdministratorGranted, fmt.Sprintf("%s: %s/%s", msg, s.instance.Namespace, s.instance.Name))
+	}
+}
+
+func describeTemporaryAccess(crb rbacv1.ClusterRoleBinding) string {
+	if len(crb.Subjects) == 0 {
+		return crb.Name
+	}
+
+	description := fmt.Sprintf("%s %s", crb.Subjects[0].Kind, crb.Subjects[0].Name)
+
+	if reason, found := crb.Annotations[temporaryAdministratorReasonAnnotation]; found {
+		description = fmt.Sprintf("%s (reason: %s)", description, reason)
+	}
+
+	return description
+}
+
+// hasExpiredTemporaryAdministrators returns true when the access of any temporary administrator expired after the cluster role bindings were last applied
+func hasExpiredTemporaryAdministrators(runtime imv1.Runtime) bool {
+	checkTime := runtime.Status.TemporaryAdministratorsCheckTime
+	for _, admin := range runtime.Spec.Security.TemporaryAdministrators {
+		if !admin.ExpiresAt.Time.After(time.Now()) && (checkTime == nil || checkTime.Before(&admin.ExpiresAt)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// nextTemporaryAdministratorExpiry returns the time until the access of the next temporary administrator expires
+func nextTemporaryAdministratorExpiry(runtime imv1.Runtime) time.Duration {
+	if !runtime.GetDeletionTimestamp().IsZero() {
+		return 0
+	}
+
+	var next time.Duration
+	for _, admin := range runtime.Spec.Security.TemporaryAdministrators {
+		if remaining := time.Until(admin.ExpiresAt.Time); remaining > 0 && (next == 0 || remaining < next) {
+			next = remaining
+		}
+	}
+
+	return next
+}
@@ -0,0 +1,203 @@
+package fsm
+
+import (
+	"context"
+	"time"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	fsm_testing "github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm/testing"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var _ = Describe("KIM temporary administrators", func() {
+	now := time.Now()
+	active := imv1.TemporaryAdministrator{Name: "oncall@acme.com", ExpiresAt: metav1.NewTime(now.Add(time.Hour)), Reason: "INC-1"}
+	expired := imv1.TemporaryAdministrator{Name: "oncall-group", Kind: rbacv1.GroupKind, ExpiresAt: metav1.NewTime(now.Add(-time.Minute))}
+
+	withName := func(crb rbacv1.ClusterRoleBinding, name string) rbacv1.ClusterRoleBinding {
+		crb.Name = name
+		return crb
+	}
+
+	DescribeTable("getTemporaryAdministratorChanges",
+		func(crbs []rbacv1.ClusterRoleBinding, admins []imv1.TemporaryAdministrator, expectedRevoked, expectedGranted []rbacv1.ClusterRoleBinding) {
+			revoked, granted := getTemporaryAdministratorChanges(crbs, admins, now)
+			Expect(revoked).To(BeComparableTo(expectedRevoked))
+			Expect(granted).To(BeComparableTo(expectedGranted))
+		},
+		Entry("should grant access to new temporary administrator",
+			nil,
+			[]imv1.TemporaryAdministrator{active},
+			nil,
+			[]rbacv1.ClusterRoleBinding{toTemporaryAdminClusterRoleBinding(active)},
+		),
+		Entry("should not grant access to expired temporary administrator",
+			nil,
+			[]imv1.TemporaryAdministrator{expired},
+			nil,
+			nil,
+		),
+		Entry("should keep access of active temporary administrator",
+			[]rbacv1.ClusterRoleBinding{withName(toTemporaryAdminClusterRoleBinding(active), "temporary-admin-1")},
+			[]imv1.TemporaryAdministrator{active},
+			nil,
+			nil,
+		),
+		Entry("should revoke access of expired and removed temporary administrators",
+			[]rbacv1.ClusterRoleBinding{
+				withName(toTemporaryAdminClusterRoleBinding(active), "temporary-admin-1"),
+				withName(toTemporaryAdminClusterRoleBinding(expired), "temporary-admin-2"),
+			},
+			[]imv1.TemporaryAdministrator{expired},
+			[]rbacv1.ClusterRoleBinding{
+				withName(toTemporaryAdminClusterRoleBinding(active), "temporary-admin-1"),
+				withName(toTemporaryAdminClusterRoleBinding(expired), "temporary-admin-2"),
+			},
+			nil,
+		),
+		Entry("should not revoke permanent administrators",
+			[]rbacv1.ClusterRoleBinding{toAdminClusterRoleBinding("oncall@acme.com")},
+			nil,
+			nil,
+			nil,
+		),
+	)
+
+	It("should not remove temporary administrators with the permanent administrators", func() {
+		crbs := []rbacv1.ClusterRoleBinding{toTemporaryAdminClusterRoleBinding(active)}
+
+		Expect(getRemoved(crbs, nil)).To(BeEmpty())
+		Expect(getMissing(crbs, getDesiredClusterRoleBindings(imv1.Security{Administrators: []string{active.Name}}))).To(HaveLen(1))
+	})
+
+	It("should grant and revoke temporary access with audit events", func() {
+		// given
+		ctx := context.Background()
+		testScheme, err := newTestScheme()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Spec.Security.TemporaryAdministrators = []imv1.TemporaryAdministrator{active, expired}
+		expiredCRB := withName(toTemporaryAdminClusterRoleBinding(expired), "temporary-admin-expired")
+
+		fsm := must(newFakeFSM,
+			withMockedMetrics(),
+			withFakedK8sClient(testScheme, runtime, &expiredCRB),
+			withFakeEventRecorder(5),
+			withDefaultReconcileDuration(),
+		)
+		originalGetShootClient := GetShootClient
+		DeferCleanup(func() { GetShootClient = originalGetShootClient })
+		GetShootClient = func(_ context.Context, _ client.Client, _ imv1.Runtime) (client.Client, error) {
+			return fsm.Client, nil
+		}
+		s := &systemState{instance: *runtime}
+
+		// when
+		next, _, _ := sFnApplyClusterRoleBindings(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnUpdateStatus"))
+		Expect(s.instance.Status.TemporaryAdministratorsCheckTime).ToNot(BeNil())
+
+		var crbs rbacv1.ClusterRoleBindingList
+		Expect(fsm.List(ctx, &crbs)).To(Succeed())
+		Expect(crbs.Items).To(HaveLen(1))
+		Expect(crbs.Items[0].Subjects[0].Name).To(Equal(active.Name))
+		Expect(crbs.Items[0].Annotations).To(HaveKeyWithValue(temporaryAdministratorExpiresAtAnnotation, active.ExpiresAt.UTC().Format(time.RFC3339)))
+		Expect(crbs.Items[0].Labels).To(HaveKeyWithValue("reconciler.kyma-project.io/managed-by", "infrastructure-manager"))
+
+		events := fsm.EventRecorder.(*record.FakeRecorder).Events
+		Expect(events).To(Receive(ContainSubstring(eventReasonTemporaryAdministratorRevoked)))
+		Expect(events).To(Receive(And(ContainSubstring(eventReasonTemporaryAdministratorGranted), ContainSubstring("INC-1"))))
+	})
+
+	It("should detect temporary administrator expired after the last check", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = imv1.RuntimeStateReady
+		runtime.Status.TemporaryAdministratorsCheckTime = &metav1.Time{Time: now.Add(-time.Hour)}
+		runtime.Spec.Security.TemporaryAdministrators = []imv1.TemporaryAdministrator{expired}
+
+		// then
+		Expect(hasExpiredTemporaryAdministrators(*runtime)).To(BeTrue())
+
+		// when
+		runtime.Status.TemporaryAdministratorsCheckTime = &metav1.Time{Time: now}
+
+		// then
+		Expect(hasExpiredTemporaryAdministrators(*runtime)).To(BeFalse())
+	})
+
+	It("should revoke expired temporary access of Failed runtime", func() {
+		// given
+		ctx := context.Background()
+		testScheme, err := newTestScheme()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = imv1.RuntimeStateFailed
+		runtime.Status.TemporaryAdministratorsCheckTime = &metav1.Time{Time: now.Add(-time.Hour)}
+		runtime.Spec.Security.TemporaryAdministrators = []imv1.TemporaryAdministrator{expired}
+		expiredCRB := withName(toTemporaryAdminClusterRoleBinding(expired), "temporary-admin-expired")
+
+		fsm := must(newFakeFSM,
+			withMockedMetrics(),
+			withFakedK8sClient(testScheme, runtime, &expiredCRB),
+			withFakeEventRecorder(1),
+			withDefaultReconcileDuration(),
+		)
+		originalGetShootClient := GetShootClient
+		DeferCleanup(func() { GetShootClient = originalGetShootClient })
+		GetShootClient = func(_ context.Context, _ client.Client, _ imv1.Runtime) (client.Client, error) {
+			return fsm.Client, nil
+		}
+		s := &systemState{instance: *runtime, shoot: fsm_testing.TestShootForPatch()}
+
+		// when
+		next, _, _ := sFnSelectShootProcessing(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnRevokeTemporaryAdministrators"))
+
+		// when
+		next, _, _ = sFnRevokeTemporaryAdministrators(ctx, fsm, s)
+
+		// then
+		Expect(next).To(haveName("sFnSelectShootProcessing"))
+		Expect(s.instance.Status.State).To(Equal(imv1.State(imv1.RuntimeStateFailed)))
+		Expect(hasExpiredTemporaryAdministrators(s.instance)).To(BeFalse())
+
+		var crbs rbacv1.ClusterRoleBindingList
+		Expect(fsm.List(ctx, &crbs)).To(Succeed())
+		Expect(crbs.Items).To(BeEmpty())
+		Expect(fsm.EventRecorder.(*record.FakeRecorder).Events).To(Receive(ContainSubstring(eventReasonTemporaryAdministratorRevoked)))
+	})
+
+	It("should requeue runtime in any state until the next temporary access expires", func() {
+		// given
+		runtime := makeInputRuntimeWithAnnotation(nil)
+		runtime.Status.State = imv1.RuntimeStateReady
+		runtime.Spec.Security.TemporaryAdministrators = []imv1.TemporaryAdministrator{active, expired}
+
+		// then
+		Expect(nextTemporaryAdministratorExpiry(*runtime)).To(BeNumerically("~", time.Hour, time.Minute))
+
+		// when
+		runtime.Status.State = imv1.RuntimeStateFailed
+
+		// then
+		Expect(nextTemporaryAdministratorExpiry(*runtime)).To(BeNumerically("~", time.Hour, time.Minute))
+
+		// when
+		runtime.DeletionTimestamp = &metav1.Time{Time: now}
+
+		// then
+		Expect(nextTemporaryAdministratorExpiry(*runtime)).To(BeZero())
+	})
+})
@@ -0,0 +1,63 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+var errTracingTest = errors.New("tracing test error")
+
+func sFnTracingTestFirst(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+	return switchState(sFnTracingTestSecond)
+}
+
+func sFnTracingTestSecond(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+	return nil, nil, errTracingTest
+}
+
+var _ = Describe("KIM fsm tracing", func() {
+	var exporter *tracetest.InMemoryExporter
+	var previousProvider trace.TracerProvider
+
+	BeforeEach(func() {
+		exporter = tracetest.NewInMemoryExporter()
+		previousProvider = otel.GetTracerProvider()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	})
+
+	AfterEach(func() {
+		otel.SetTracerProvider(previousProvider)
+	})
+
+	It("should create a span for every state function", func() {
+		// given
+		testScheme := runtime.NewScheme()
+		util.Must(imv1.AddToScheme(testScheme))
+		fsm := must(newFakeFSM, withMockedMetrics(), withFakedK8sClient(testScheme))
+		fsm.fn = sFnTracingTestFirst
+
+		// when
+		_, err := fsm.Run(context.Background(), imv1.Runtime{})
+
+		// then
+		Expect(err).To(MatchError(errTracingTest))
+		spans := exporter.GetSpans()
+		Expect(spans).To(HaveLen(2))
+		Expect(spans[0].Name).To(Equal("sFnTracingTestFirst"))
+		Expect(spans[0].Status.Code).To(Equal(codes.Unset))
+		Expect(spans[1].Name).To(Equal("sFnTracingTestSecond"))
+		Expect(spans[1].Status.Code).To(Equal(codes.Error))
+	})
+})
@@ -3,6 +3,7 @@ package fsm
 import (
 	"context"
 	"reflect"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 )
@@ -18,6 +19,8 @@ func sFnUpdateStatus(result *ctrl.Result, err error) stateFn {
 			return nil, result, err
 		}
 
+		s.saveTransition(result, err)
+
 		updateErr := m.Status().Update(ctx, &s.instance)
 
 		if updateErr != nil {
@@ -29,6 +32,10 @@ func sFnUpdateStatus(result *ctrl.Result, err error) stateFn {
 		}
 
 		m.Metrics.SetRuntimeStates(s.instance)
+		if !s.snapshot.ProvisioningCompleted && s.instance.Status.ProvisioningCompleted {
+			m.Metrics.ObserveRuntimeTimeToReady(s.instance, time.Since(s.instance.CreationTimestamp.Time))
+		}
+
 		next := sFnEmmitEventfunc(nil, result, err)
 		return next, nil, nil
 	}
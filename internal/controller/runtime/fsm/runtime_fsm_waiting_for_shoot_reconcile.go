@@ -21,10 +21,16 @@ func sFnWaitForShootReconcile(_ context.Context, m *fsm, s *systemState) (stateF
 			imv1.ConditionReasonProcessing,
 			"Unknown",
 			"Shoot update is in progress")
+		setTimeoutConditionIfExceeded(m, s, shootOperationUpdate)
 
 		return updateStatusAndRequeueAfter(m.RequeueDurationShootReconcile)
 
 	case gardener.LastOperationStateFailed:
+		if isRetryPending(s) {
+			m.log.V(log_level.DEBUG).Info(fmt.Sprintf("Retry requested for Shoot %s is not started yet, scheduling for retry", s.shoot.Name))
+			return requeueAfter(m.RequeueDurationShootReconcile)
+		}
+
 		lastErrors := s.shoot.Status.LastErrors
 		reason := imgardenerhandler.ToErrReason(lastErrors...)
 
@@ -35,6 +41,7 @@ func sFnWaitForShootReconcile(_ context.Context, m *fsm, s *systemState) (stateF
 				imv1.ConditionReasonShootCreationPending,
 				"Unknown",
 				"Retryable gardener errors during cluster reconcile")
+			setTimeoutConditionIfExceeded(m, s, shootOperationUpdate)
 			return updateStatusAndRequeueAfter(m.RequeueDurationShootReconcile)
 		}
 
@@ -47,10 +54,22 @@ func sFnWaitForShootReconcile(_ context.Context, m *fsm, s *systemState) (stateF
 			"False",
 			string(reason),
 		)
+		clearTimeoutCondition(&s.instance)
+
+		if shouldRetryAutomatically(m.RetryPolicy, s) {
+			return updateStatusAndRequeueAfter(retryDelay(m.RetryPolicy, s))
+		}
+
 		m.Metrics.IncRuntimeFSMStopCounter()
 		return updateStatusAndStop()
 
 	case gardener.LastOperationStateSucceeded:
+		clearTimeoutCondition(&s.instance)
+		resetRetryAttempts(&s.instance)
+		if s.shoot.Status.IsHibernated {
+			return switchState(sFnShootHibernated)
+		}
+
 		m.log.Info(fmt.Sprintf("Shoot %s successfully updated, moving to processing", s.shoot.Name))
 		return ensureStatusConditionIsSetAndContinue(
 			&s.instance,
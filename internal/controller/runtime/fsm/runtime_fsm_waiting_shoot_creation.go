@@ -49,10 +49,16 @@ func sFnWaitForShootCreation(_ context.Context, m *fsm, s *systemState) (stateFn
 			imv1.ConditionReasonShootCreationPending,
 			"Unknown",
 			"Shoot creation in progress")
+		setTimeoutConditionIfExceeded(m, s, shootOperationCreate)
 
 		return updateStatusAndRequeueAfter(m.RequeueDurationShootCreate)
 
 	case gardener.LastOperationStateFailed:
+		if isRetryPending(s) {
+			m.log.V(log_level.DEBUG).Info(fmt.Sprintf("Retry requested for Shoot %s is not started yet, scheduling for retry", s.shoot.Name))
+			return requeueAfter(m.RequeueDurationShootCreate)
+		}
+
 		lastErrors := s.shoot.Status.LastErrors
 		reason := imgardenerhandler.ToErrReason(lastErrors...)
 
@@ -63,6 +69,7 @@ func sFnWaitForShootCreation(_ context.Context, m *fsm, s *systemState) (stateFn
 				imv1.ConditionReasonShootCreationPending,
 				"Unknown",
 				"Retryable gardener errors during cluster provisioning")
+			setTimeoutConditionIfExceeded(m, s, shootOperationCreate)
 			return updateStatusAndRequeueAfter(m.RequeueDurationShootCreate)
 		}
 
@@ -74,11 +81,22 @@ func sFnWaitForShootCreation(_ context.Context, m *fsm, s *systemState) (stateFn
 			imv1.ConditionReasonCreationError,
 			"False",
 			"Shoot creation failed")
+		clearTimeoutCondition(&s.instance)
+
+		if shouldRetryAutomatically(m.RetryPolicy, s) {
+			return updateStatusAndRequeueAfter(retryDelay(m.RetryPolicy, s))
+		}
 
 		m.Metrics.IncRuntimeFSMStopCounter()
 		return updateStatusAndStop()
 
 	case gardener.LastOperationStateSucceeded:
+		clearTimeoutCondition(&s.instance)
+		resetRetryAttempts(&s.instance)
+		if s.shoot.Status.IsHibernated {
+			return switchState(sFnShootHibernated)
+		}
+
 		m.log.Info(fmt.Sprintf("Shoot %s successfully created", s.shoot.Name))
 		return ensureStatusConditionIsSetAndContinue(
 			&s.instance,
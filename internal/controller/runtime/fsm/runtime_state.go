@@ -1,15 +1,27 @@
 package fsm
 
 import (
+	"fmt"
+	"slices"
+
 	gardener_api "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
 )
 
+// maximal number of reconciliation outcomes stored in the Runtime status
+const transitionHistoryLimit = 20
+
 // the state of controlled system (k8s cluster)
 type systemState struct {
 	instance imv1.Runtime
 	snapshot imv1.RuntimeStatus
 	shoot    *gardener_api.Shoot
+	// state functions visited during the current reconciliation, the status update and events are not included
+	visitedStates []string
+	// set when the outcome of the current reconciliation is stored together with the status update
+	transitionSaved bool
 }
 
 func (s *systemState) saveRuntimeStatus() {
@@ -19,3 +31,59 @@ func (s *systemState) saveRuntimeStatus() {
 	}
 	s.snapshot = *result
 }
+
+func (s *systemState) visitState(stateName string) {
+	s.visitedStates = append(s.visitedStates, stateName)
+}
+
+// saveTransition appends the outcome of the current reconciliation to the history stored in status
+func (s *systemState) saveTransition(result *ctrl.Result, err error) {
+	s.instance.Status.TransitionHistory = appendTransition(s.instance.Status.TransitionHistory, s.newTransition(result, err))
+	s.transitionSaved = true
+}
+
+// newTransition returns the outcome of the current reconciliation, the last visited state stopped or requeued the Runtime
+func (s *systemState) newTransition(result *ctrl.Result, err error) imv1.StateTransition {
+	transition := imv1.StateTransition{
+		Timestamp: metav1.Now(),
+		Result:    resultToString(result),
+		States:    slices.Clone(s.visitedStates),
+	}
+
+	if len(s.visitedStates) > 0 {
+		transition.State = s.visitedStates[len(s.visitedStates)-1]
+	}
+
+	if err != nil {
+		transition.Error = err.Error()
+	}
+
+	return transition
+}
+
+// appends the transition to the history and drops the oldest entries
+func appendTransition(history []imv1.StateTransition, transition imv1.StateTransition) []imv1.StateTransition {
+	history = append(history, transition)
+	if len(history) > transitionHistoryLimit {
+		history = history[len(history)-transitionHistoryLimit:]
+	}
+
+	return history
+}
+
+func isSameOutcome(a, b imv1.StateTransition) bool {
+	return a.State == b.State && slices.Equal(a.States, b.States) && a.Result == b.Result && a.Error == b.Error
+}
+
+func resultToString(result *ctrl.Result) string {
+	switch {
+	case result == nil:
+		return ""
+	case result.RequeueAfter > 0:
+		return fmt.Sprintf("requeue after %s", result.RequeueAfter)
+	case result.Requeue:
+		return "requeue"
+	}
+
+	return ""
+}
@@ -0,0 +1,105 @@
+package fsm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	. "github.com/onsi/ginkgo/v2" //nolint:revive
+	. "github.com/onsi/gomega"    //nolint:revive
+	"k8s.io/apimachinery/pkg/runtime"
+	util "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func sFnTransitionTestFirst(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+	return switchState(sFnTransitionTestSecond)
+}
+
+func sFnTransitionTestSecond(_ context.Context, _ *fsm, _ *systemState) (stateFn, *ctrl.Result, error) {
+	return requeueAfter(30 * time.Second)
+}
+
+var _ = Describe("KIM transition history", func() {
+	testScheme := runtime.NewScheme()
+	util.Must(imv1.AddToScheme(testScheme))
+	util.Must(gardener.AddToScheme(testScheme))
+
+	It("should save the outcome with the visited states", func() {
+		// given
+		s := systemState{}
+		s.visitState("sFnInitialize")
+		s.visitState("sFnPatchExistingShoot")
+
+		// when
+		s.saveTransition(nil, errors.New("test error"))
+
+		// then
+		history := s.instance.Status.TransitionHistory
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].State).To(Equal("sFnPatchExistingShoot"))
+		Expect(history[0].States).To(Equal([]string{"sFnInitialize", "sFnPatchExistingShoot"}))
+		Expect(history[0].Result).To(BeEmpty())
+		Expect(history[0].Error).To(Equal("test error"))
+		Expect(s.transitionSaved).To(BeTrue())
+	})
+
+	It("should keep only the most recent outcomes", func() {
+		// given
+		s := systemState{}
+		for i := 0; i < transitionHistoryLimit; i++ {
+			s.instance.Status.TransitionHistory = append(s.instance.Status.TransitionHistory, imv1.StateTransition{State: fmt.Sprintf("old-%d", i)})
+		}
+		s.visitState("sFnInitialize")
+
+		// when
+		s.saveTransition(&ctrl.Result{Requeue: true}, nil)
+
+		// then
+		history := s.instance.Status.TransitionHistory
+		Expect(history).To(HaveLen(transitionHistoryLimit))
+		Expect(history[0].State).To(Equal("old-1"))
+		Expect(history[transitionHistoryLimit-1].State).To(Equal("sFnInitialize"))
+		Expect(history[transitionHistoryLimit-1].Result).To(Equal("requeue"))
+	})
+
+	It("should store the outcome of the reconciliation without status changes once", func() {
+		// given
+		ctx := context.Background()
+		rt := makeInputRuntimeWithAnnotation(nil)
+		fsm := setupFakeFSMForTest(testScheme, rt)
+		var stored imv1.Runtime
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(rt), &stored)).To(Succeed())
+
+		// when
+		fsm.fn = sFnTransitionTestFirst
+		_, err := fsm.Run(ctx, stored)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(rt), &stored)).To(Succeed())
+		history := stored.Status.TransitionHistory
+		Expect(history).To(HaveLen(1))
+		Expect(history[0].State).To(Equal("sFnTransitionTestSecond"))
+		Expect(history[0].States).To(Equal([]string{"sFnTransitionTestFirst", "sFnTransitionTestSecond"}))
+		Expect(history[0].Result).To(Equal("requeue after 30s"))
+
+		// when
+		fsm.fn = sFnTransitionTestFirst
+		_, err = fsm.Run(ctx, stored)
+
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fsm.Get(ctx, client.ObjectKeyFromObject(rt), &stored)).To(Succeed())
+		Expect(stored.Status.TransitionHistory).To(HaveLen(1))
+	})
+
+	It("should shorten state function names", func() {
+		Expect(shortName(stateFn(sFnTakeSnapshot).name())).To(Equal("sFnTakeSnapshot"))
+		Expect(shortName(sFnUpdateStatus(nil, nil).name())).To(Equal("sFnUpdateStatus"))
+	})
+})
@@ -68,6 +68,11 @@ var (
 		m.On("SetRuntimeStates", mock.Anything).Return()
 		m.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 		m.On("IncRuntimeFSMStopCounter").Return()
+		m.On("ObserveRuntimeFSMState", mock.Anything, mock.Anything, mock.Anything).Return()
+		m.On("ObserveRuntimeTimeToReady", mock.Anything, mock.Anything).Return()
+		m.On("IncRuntimeOperationTimeoutCounter", mock.Anything, mock.Anything).Return()
+		m.On("SetRuntimeShootDrift", mock.Anything, mock.Anything).Return()
+		m.On("SetRuntimeRemainingLifetime", mock.Anything, mock.Anything).Return()
 		return withMetrics(m)
 	}
 
@@ -78,6 +83,15 @@ var (
 		}
 	}
 
+	withTimeouts = func(create, update, del time.Duration) fakeFSMOpt {
+		return func(fsm *fsm) error {
+			fsm.TimeoutsConfig.ShootCreate = metav1.Duration{Duration: create}
+			fsm.TimeoutsConfig.ShootUpdate = metav1.Duration{Duration: update}
+			fsm.TimeoutsConfig.ShootDelete = metav1.Duration{Duration: del}
+			return nil
+		}
+	}
+
 	withStructuredAuthEnabled = func(enabled bool) fakeFSMOpt {
 		return func(fsm *fsm) error {
 			fsm.StructuredAuthEnabled = enabled
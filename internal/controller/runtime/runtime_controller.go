@@ -20,16 +20,26 @@ import (
 	"context"
 	"sync/atomic"
 
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/go-logr/logr"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/configwatch"
 	"github.com/kyma-project/infrastructure-manager/internal/controller/runtime/fsm"
 	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // RuntimeReconciler reconciles a Runtime object
@@ -42,17 +52,30 @@ type RuntimeReconciler struct {
 	Cfg           fsm.RCCfg
 	EventRecorder record.EventRecorder
 	RequestID     atomic.Uint64
+	// ConfigWatcher provides the current configuration, Cfg is used as is when not set
+	ConfigWatcher *configwatch.Watcher
+	// ShootCache provides the shoot events from the Gardener cluster, the shoots are only polled when not set
+	ShootCache cache.Cache
 }
 
 //+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes,verbs=get;list;watch;create;update;patch,namespace=kcp-system
 //+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes/status,verbs=get;list;delete;create;update;patch,namespace=kcp-system
 //+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimes/finalizers,verbs=get;list;delete;create;update;patch,namespace=kcp-system
 
-func (r *RuntimeReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+func (r *RuntimeReconciler) Reconcile(ctx context.Context, request ctrl.Request) (result ctrl.Result, err error) {
 	r.Log.V(log_level.TRACE).Info(request.String())
 
+	ctx, span := tracing.Tracer().Start(ctx, "RuntimeReconciler.Reconcile", trace.WithAttributes(
+		attribute.String("k8s.namespace", request.Namespace),
+		attribute.String("k8s.name", request.Name),
+	))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	var runtime imv1.Runtime
 	if err := r.Get(ctx, request.NamespacedName, &runtime); err != nil {
+		if apierrors.IsNotFound(err) {
+			r.Cfg.RequeueBackoff.Reset(request.NamespacedName)
+		}
 		return ctrl.Result{
 			Requeue: false,
 		}, client.IgnoreNotFound(err)
@@ -63,12 +86,14 @@ func (r *RuntimeReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 		runtimeID = runtime.Name
 	}
 
+	span.SetAttributes(attribute.String("kyma.runtime_id", runtimeID), attribute.String("kyma.shoot_name", runtime.Spec.Shoot.Name))
+
 	log := r.Log.WithValues("runtimeID", runtimeID, "shootName", runtime.Spec.Shoot.Name, "requestID", r.RequestID.Add(1))
 	log.Info("Reconciling Runtime", "Name", runtime.Name, "Namespace", runtime.Namespace)
 
 	stateFSM := fsm.NewFsm(
 		log,
-		r.Cfg,
+		r.fsmConfig(),
 		fsm.K8s{
 			Client:        r.Client,
 			ShootClient:   r.ShootClient,
@@ -78,6 +103,21 @@ func (r *RuntimeReconciler) Reconcile(ctx context.Context, request ctrl.Request)
 	return stateFSM.Run(ctx, runtime)
 }
 
+// fsmConfig returns the state machine configuration with the latest configuration snapshot
+func (r *RuntimeReconciler) fsmConfig() fsm.RCCfg {
+	cfg := r.Cfg
+	if r.ConfigWatcher == nil {
+		return cfg
+	}
+
+	snapshot := r.ConfigWatcher.Snapshot()
+	cfg.Config = snapshot.Config
+	cfg.AuditLogging = snapshot.AuditLogging
+	cfg.MaintenanceWindows = snapshot.MaintenanceWindows
+
+	return cfg
+}
+
 func NewRuntimeReconciler(mgr ctrl.Manager, shootClient client.Client, logger logr.Logger, cfg fsm.RCCfg) *RuntimeReconciler {
 	return &RuntimeReconciler{
 		Client:        mgr.GetClient(),
@@ -91,13 +131,22 @@ func NewRuntimeReconciler(mgr ctrl.Manager, shootClient client.Client, logger lo
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *RuntimeReconciler) SetupWithManager(mgr ctrl.Manager, numberOfWorkers int) error {
-	return ctrl.NewControllerManagedBy(mgr).
-		For(&imv1.Runtime{}).
-		WithOptions(controller.Options{MaxConcurrentReconciles: numberOfWorkers}).
-		WithEventFilter(predicate.Or(
+	b := ctrl.NewControllerManagedBy(mgr).
+		For(&imv1.Runtime{}, builder.WithPredicates(predicate.Or(
 			predicate.GenerationChangedPredicate{},
 			predicate.LabelChangedPredicate{},
 			predicate.AnnotationChangedPredicate{},
-		)).
-		Complete(r)
+		))).
+		WithOptions(controller.Options{MaxConcurrentReconciles: numberOfWorkers})
+
+	if r.ShootCache != nil {
+		b = b.WatchesRawSource(source.Kind(
+			r.ShootCache,
+			&gardener.Shoot{},
+			handler.TypedEnqueueRequestsFromMapFunc(r.mapShootToRuntimes),
+			shootLastOperationChanged(),
+		))
+	}
+
+	return b.Complete(r)
 }
@@ -0,0 +1,57 @@
+package runtime
+
+import (
+	"context"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// shootLastOperationChanged passes the shoot events which can move the Runtime to the next state,
+// the shoot creation is not passed as the Runtime is reconciled after the shoot is created anyway
+func shootLastOperationChanged() predicate.TypedPredicate[*gardener.Shoot] {
+	return predicate.TypedFuncs[*gardener.Shoot]{
+		CreateFunc: func(event.TypedCreateEvent[*gardener.Shoot]) bool {
+			return false
+		},
+		UpdateFunc: func(e event.TypedUpdateEvent[*gardener.Shoot]) bool {
+			oldOperation, newOperation := e.ObjectOld.Status.LastOperation, e.ObjectNew.Status.LastOperation
+			if oldOperation == nil || newOperation == nil {
+				return oldOperation != newOperation
+			}
+			return oldOperation.Type != newOperation.Type || oldOperation.State != newOperation.State
+		},
+		DeleteFunc: func(event.TypedDeleteEvent[*gardener.Shoot]) bool {
+			return true
+		},
+		GenericFunc: func(event.TypedGenericEvent[*gardener.Shoot]) bool {
+			return false
+		},
+	}
+}
+
+// mapShootToRuntimes returns the Runtime with the runtime ID stored in the shoot annotation
+func (r *RuntimeReconciler) mapShootToRuntimes(ctx context.Context, shoot *gardener.Shoot) []reconcile.Request {
+	runtimeID, ok := shoot.Annotations[extender.ShootRuntimeIDAnnotation]
+	if !ok || runtimeID == "" {
+		return nil
+	}
+
+	var runtimes imv1.RuntimeList
+	if err := r.List(ctx, &runtimes, client.MatchingLabels{imv1.LabelKymaRuntimeID: runtimeID}); err != nil {
+		r.Log.Error(err, "Failed to list Runtimes for shoot", "shoot", shoot.Name, "runtimeID", runtimeID)
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(runtimes.Items))
+	for _, runtime := range runtimes.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&runtime)})
+	}
+
+	return requests
+}
@@ -0,0 +1,105 @@
+package runtime
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/go-logr/logr"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestMapShootToRuntimes(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, imv1.AddToScheme(scheme))
+
+	runtimeCR := &imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime-cr",
+			Namespace: "kcp-system",
+			Labels:    map[string]string{imv1.LabelKymaRuntimeID: "runtime-id"},
+		},
+	}
+	reconciler := &RuntimeReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(runtimeCR).Build(),
+		Log:    logr.Discard(),
+	}
+
+	t.Run("Should map shoot to the Runtime with the annotated runtime ID", func(t *testing.T) {
+		// given
+		shoot := fixShootWithLastOperation(gardener.LastOperationStateProcessing)
+		shoot.Annotations = map[string]string{extender.ShootRuntimeIDAnnotation: "runtime-id"}
+
+		// when
+		requests := reconciler.mapShootToRuntimes(context.Background(), shoot)
+
+		// then
+		assert.Equal(t, []reconcile.Request{
+			{NamespacedName: types.NamespacedName{Name: "runtime-cr", Namespace: "kcp-system"}},
+		}, requests)
+	})
+
+	t.Run("Should not map shoot without runtime ID annotation", func(t *testing.T) {
+		// when
+		requests := reconciler.mapShootToRuntimes(context.Background(), fixShootWithLastOperation(gardener.LastOperationStateProcessing))
+
+		// then
+		assert.Empty(t, requests)
+	})
+}
+
+func TestShootLastOperationChanged(t *testing.T) {
+	p := shootLastOperationChanged()
+
+	t.Run("Should pass update when last operation state changed", func(t *testing.T) {
+		assert.True(t, p.Update(event.TypedUpdateEvent[*gardener.Shoot]{
+			ObjectOld: fixShootWithLastOperation(gardener.LastOperationStateProcessing),
+			ObjectNew: fixShootWithLastOperation(gardener.LastOperationStateSucceeded),
+		}))
+	})
+
+	t.Run("Should pass update when last operation is set", func(t *testing.T) {
+		assert.True(t, p.Update(event.TypedUpdateEvent[*gardener.Shoot]{
+			ObjectOld: &gardener.Shoot{},
+			ObjectNew: fixShootWithLastOperation(gardener.LastOperationStateProcessing),
+		}))
+	})
+
+	t.Run("Should skip update when only progress changed", func(t *testing.T) {
+		shoot := fixShootWithLastOperation(gardener.LastOperationStateProcessing)
+		shoot.Status.LastOperation.Progress = 50
+
+		assert.False(t, p.Update(event.TypedUpdateEvent[*gardener.Shoot]{
+			ObjectOld: fixShootWithLastOperation(gardener.LastOperationStateProcessing),
+			ObjectNew: shoot,
+		}))
+	})
+
+	t.Run("Should pass delete and skip create", func(t *testing.T) {
+		shoot := fixShootWithLastOperation(gardener.LastOperationStateSucceeded)
+
+		assert.True(t, p.Delete(event.TypedDeleteEvent[*gardener.Shoot]{Object: shoot}))
+		assert.False(t, p.Create(event.TypedCreateEvent[*gardener.Shoot]{Object: shoot}))
+	})
+}
+
+func fixShootWithLastOperation(state gardener.LastOperationState) *gardener.Shoot {
+	return &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-test"},
+		Status: gardener.ShootStatus{
+			LastOperation: &gardener.LastOperation{
+				Type:  gardener.LastOperationTypeReconcile,
+				State: state,
+			},
+		},
+	}
+}
@@ -118,6 +118,11 @@ var _ = BeforeSuite(func() {
 	mm := &mocks.Metrics{}
 	mm.On("SetRuntimeStates", mock.Anything).Return()
 	mm.On("IncRuntimeFSMStopCounter").Return()
+	mm.On("ObserveRuntimeFSMState", mock.Anything, mock.Anything, mock.Anything).Return()
+	mm.On("ObserveRuntimeTimeToReady", mock.Anything, mock.Anything).Return()
+	mm.On("IncRuntimeOperationTimeoutCounter", mock.Anything, mock.Anything).Return()
+	mm.On("SetRuntimeShootDrift", mock.Anything, mock.Anything).Return()
+	mm.On("SetRuntimeRemainingLifetime", mock.Anything, mock.Anything).Return()
 	mm.On("CleanUpRuntimeGauge", mock.Anything, mock.Anything).Return()
 
 	fsmCfg := fsm.RCCfg{
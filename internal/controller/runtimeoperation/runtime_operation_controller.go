@@ -0,0 +1,292 @@
+package runtimeoperation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig"
+	"github.com/kyma-project/infrastructure-manager/internal/log_level"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+const (
+	// progressCheckInterval is the interval of checking whether the requested operation completed
+	progressCheckInterval = 30 * time.Second
+	// operationTimeout limits how long the operation may stay pending or in progress
+	operationTimeout = time.Hour
+
+	eventReasonOperationSucceeded = "RuntimeOperationSucceeded"
+	eventReasonOperationFailed    = "RuntimeOperationFailed"
+)
+
+// RuntimeOperationReconciler executes the operations requested for the Runtimes and tracks their completion
+// nolint:revive
+type RuntimeOperationReconciler struct {
+	client.Client
+	Scheme         *runtime.Scheme
+	ShootClient    client.Client
+	ShootNamespace string
+	Log            logr.Logger
+	EventRecorder  record.EventRecorder
+}
+
+//+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimeoperations,verbs=get;list;watch;update;patch,namespace=kcp-system
+//+kubebuilder:rbac:groups=infrastructuremanager.kyma-project.io,resources=runtimeoperations/status,verbs=get;update;patch,namespace=kcp-system
+
+func (r *RuntimeOperationReconciler) Reconcile(ctx context.Context, request ctrl.Request) (ctrl.Result, error) {
+	r.Log.V(log_level.TRACE).Info(request.String())
+
+	var operation imv1.RuntimeOperation
+	if err := r.Get(ctx, request.NamespacedName, &operation); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if operation.IsFinished() {
+		return ctrl.Result{}, nil
+	}
+
+	log := r.Log.WithValues("RuntimeOperation", operation.Name, "Runtime", operation.Spec.RuntimeName, "type", operation.Spec.Type)
+
+	var rt imv1.Runtime
+	if err := r.Get(ctx, types.NamespacedName{Name: operation.Spec.RuntimeName, Namespace: operation.Namespace}, &rt); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.fail(ctx, &operation, fmt.Sprintf("Runtime %s not found", operation.Spec.RuntimeName))
+		}
+		return ctrl.Result{}, err
+	}
+
+	if isTimedOut(operation) {
+		return r.fail(ctx, &operation, fmt.Sprintf("Operation not completed within %s", operationTimeout))
+	}
+
+	if operation.Status.StartTime == nil {
+		return r.start(ctx, log, &operation, rt)
+	}
+
+	return r.checkProgress(ctx, log, &operation, rt)
+}
+
+// start executes the operation unless another Gardener operation is still pending for the shoot
+func (r *RuntimeOperationReconciler) start(ctx context.Context, log logr.Logger, operation *imv1.RuntimeOperation, rt imv1.Runtime) (ctrl.Result, error) {
+	if !rt.DeletionTimestamp.IsZero() {
+		return r.fail(ctx, operation, "Runtime is being deleted")
+	}
+
+	startTime := metav1.Now()
+
+	if gardenerOperation, ok := gardenerOperations[operation.Spec.Type]; ok {
+		shoot, err := r.getShoot(ctx, rt)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return r.fail(ctx, operation, fmt.Sprintf("Shoot %s not found", rt.Spec.Shoot.Name))
+			}
+			return ctrl.Result{}, err
+		}
+
+		if _, found := shoot.Annotations[v1beta1constants.GardenerOperation]; found {
+			log.Info("Another operation is pending for the shoot, waiting", "shoot", shoot.Name)
+			return r.updateState(ctx, operation, imv1.RuntimeOperationStatePending)
+		}
+
+		if operation.Spec.Type == imv1.RuntimeOperationRetry && !isShootFailed(shoot) {
+			return r.fail(ctx, operation, "Retry requires the failed shoot operation")
+		}
+
+		original := shoot.DeepCopy()
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, gardenerOperation)
+		if err := r.ShootClient.Patch(ctx, shoot, client.MergeFrom(original)); err != nil {
+			return ctrl.Result{}, err
+		}
+	} else if err := r.executeKIMAction(ctx, operation.Spec.Type, rt); err != nil {
+		if apierrors.IsNotFound(err) {
+			return r.fail(ctx, operation, err.Error())
+		}
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Runtime operation started")
+	operation.Status.StartTime = &startTime
+
+	return r.updateState(ctx, operation, imv1.RuntimeOperationStateInProgress)
+}
+
+// gardenerOperations maps the operations to the values of the Gardener operation annotation
+var gardenerOperations = map[imv1.RuntimeOperationType]string{ //nolint:gochecknoglobals
+	imv1.RuntimeOperationReconcile: v1beta1constants.GardenerOperationReconcile,
+	imv1.RuntimeOperationRetry:     v1beta1constants.ShootOperationRetry,
+	imv1.RuntimeOperationMaintain:  v1beta1constants.ShootOperationMaintain,
+}
+
+// executeKIMAction sets the annotation handled by the Infrastructure Manager controllers
+func (r *RuntimeOperationReconciler) executeKIMAction(ctx context.Context, operationType imv1.RuntimeOperationType, rt imv1.Runtime) error {
+	switch operationType {
+	case imv1.RuntimeOperationRotateKubeconfig:
+		var cluster imv1.GardenerCluster
+		if err := r.Get(ctx, gardenerClusterKey(rt), &cluster); err != nil {
+			return err
+		}
+
+		original := cluster.DeepCopy()
+		metav1.SetMetaDataAnnotation(&cluster.ObjectMeta, kubeconfig.ForceKubeconfigRotationAnnotation, "true")
+		return r.Patch(ctx, &cluster, client.MergeFrom(original))
+	case imv1.RuntimeOperationForcePatch:
+		original := rt.DeepCopy()
+		metav1.SetMetaDataAnnotation(&rt.ObjectMeta, reconciler.ForceReconcileAnnotation, "true")
+		return r.Patch(ctx, &rt, client.MergeFrom(original))
+	default:
+		return fmt.Errorf("unsupported operation type %s", operationType)
+	}
+}
+
+// checkProgress completes the operation once the annotation was processed
+func (r *RuntimeOperationReconciler) checkProgress(ctx context.Context, log logr.Logger, operation *imv1.RuntimeOperation, rt imv1.Runtime) (ctrl.Result, error) {
+	if _, ok := gardenerOperations[operation.Spec.Type]; ok {
+		shoot, err := r.getShoot(ctx, rt)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return r.fail(ctx, operation, fmt.Sprintf("Shoot %s not found", rt.Spec.Shoot.Name))
+			}
+			return ctrl.Result{}, err
+		}
+
+		lastOperation := shoot.Status.LastOperation
+		_, pending := shoot.Annotations[v1beta1constants.GardenerOperation]
+		if pending || lastOperation == nil || lastOperation.LastUpdateTime.Before(operation.Status.StartTime) {
+			return ctrl.Result{RequeueAfter: progressCheckInterval}, nil
+		}
+
+		switch lastOperation.State {
+		case gardener.LastOperationStateSucceeded:
+			return r.succeed(ctx, log, operation)
+		case gardener.LastOperationStateFailed:
+			return r.fail(ctx, operation, lastErrorsMessage(shoot))
+		default:
+			return ctrl.Result{RequeueAfter: progressCheckInterval}, nil
+		}
+	}
+
+	var obj client.Object = &rt
+	annotation := reconciler.ForceReconcileAnnotation
+	if operation.Spec.Type == imv1.RuntimeOperationRotateKubeconfig {
+		obj = &imv1.GardenerCluster{}
+		annotation = kubeconfig.ForceKubeconfigRotationAnnotation
+		if err := r.Get(ctx, gardenerClusterKey(rt), obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				return r.fail(ctx, operation, err.Error())
+			}
+			return ctrl.Result{}, err
+		}
+	}
+
+	if _, found := obj.GetAnnotations()[annotation]; found {
+		return ctrl.Result{RequeueAfter: progressCheckInterval}, nil
+	}
+
+	return r.succeed(ctx, log, operation)
+}
+
+func (r *RuntimeOperationReconciler) getShoot(ctx context.Context, rt imv1.Runtime) (*gardener.Shoot, error) {
+	var shoot gardener.Shoot
+	err := r.ShootClient.Get(ctx, types.NamespacedName{Name: rt.Spec.Shoot.Name, Namespace: r.ShootNamespace}, &shoot)
+
+	return &shoot, err
+}
+
+func (r *RuntimeOperationReconciler) succeed(ctx context.Context, log logr.Logger, operation *imv1.RuntimeOperation) (ctrl.Result, error) {
+	log.Info("Runtime operation succeeded")
+	operation.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	r.EventRecorder.Event(operation, corev1.EventTypeNormal, eventReasonOperationSucceeded, fmt.Sprintf("Operation %s succeeded", operation.Spec.Type))
+
+	return r.updateState(ctx, operation, imv1.RuntimeOperationStateSucceeded)
+}
+
+func (r *RuntimeOperationReconciler) fail(ctx context.Context, operation *imv1.RuntimeOperation, msg string) (ctrl.Result, error) {
+	r.Log.Info("Runtime operation failed", "RuntimeOperation", operation.Name, "error", msg)
+	operation.Status.CompletionTime = &metav1.Time{Time: time.Now()}
+	operation.Status.Error = msg
+	r.EventRecorder.Event(operation, corev1.EventTypeWarning, eventReasonOperationFailed, fmt.Sprintf("Operation %s failed: %s", operation.Spec.Type, msg))
+
+	return r.updateState(ctx, operation, imv1.RuntimeOperationStateFailed)
+}
+
+func (r *RuntimeOperationReconciler) updateState(ctx context.Context, operation *imv1.RuntimeOperation, state imv1.RuntimeOperationState) (ctrl.Result, error) {
+	operation.Status.State = state
+	if err := r.Status().Update(ctx, operation); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if operation.IsFinished() {
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: progressCheckInterval}, nil
+}
+
+// isTimedOut returns true when the operation was not completed in time, measured from the start or creation of the operation
+func isTimedOut(operation imv1.RuntimeOperation) bool {
+	since := operation.CreationTimestamp
+	if operation.Status.StartTime != nil {
+		since = *operation.Status.StartTime
+	}
+
+	return !since.IsZero() && time.Since(since.Time) > operationTimeout
+}
+
+func isShootFailed(shoot *gardener.Shoot) bool {
+	return shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardener.LastOperationStateFailed
+}
+
+func lastErrorsMessage(shoot *gardener.Shoot) string {
+	if len(shoot.Status.LastErrors) == 0 {
+		return shoot.Status.LastOperation.Description
+	}
+
+	descriptions := make([]string, 0, len(shoot.Status.LastErrors))
+	for _, lastError := range shoot.Status.LastErrors {
+		descriptions = append(descriptions, lastError.Description)
+	}
+
+	return strings.Join(descriptions, "; ")
+}
+
+// gardenerClusterKey returns the key of the GardenerCluster created for the Runtime, named after the runtime ID
+func gardenerClusterKey(rt imv1.Runtime) types.NamespacedName {
+	return types.NamespacedName{Name: rt.Labels[imv1.LabelKymaRuntimeID], Namespace: rt.Namespace}
+}
+
+func NewRuntimeOperationReconciler(mgr ctrl.Manager, shootClient client.Client, shootNamespace string, logger logr.Logger) *RuntimeOperationReconciler {
+	return &RuntimeOperationReconciler{
+		Client:         mgr.GetClient(),
+		Scheme:         mgr.GetScheme(),
+		ShootClient:    shootClient,
+		ShootNamespace: shootNamespace,
+		EventRecorder:  mgr.GetEventRecorderFor("runtime-operation-controller"),
+		Log:            logger,
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *RuntimeOperationReconciler) SetupWithManager(mgr ctrl.Manager, numberOfWorkers int) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&imv1.RuntimeOperation{}).
+		WithOptions(controller.Options{MaxConcurrentReconciles: numberOfWorkers}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Named("runtime-operation-controller").
+		Complete(r)
+}
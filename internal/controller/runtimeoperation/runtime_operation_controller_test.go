@@ -0,0 +1,305 @@
+package runtimeoperation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/controller/kubeconfig"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	testNamespace      = "kcp-system"
+	testShootNamespace = "garden-test"
+)
+
+func TestRuntimeOperationReconciler(t *testing.T) {
+	t.Run("Should request Gardener operation and move to InProgress", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationReconcile)
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, fixShoot(nil))
+
+		// when
+		result, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, progressCheckInterval, result.RequeueAfter)
+
+		shoot := getShoot(t, r)
+		assert.Equal(t, v1beta1constants.GardenerOperationReconcile, shoot.Annotations[v1beta1constants.GardenerOperation])
+
+		updated := getOperation(t, r, operation)
+		assert.Equal(t, imv1.RuntimeOperationStateInProgress, updated.Status.State)
+		assert.NotNil(t, updated.Status.StartTime)
+		assert.Nil(t, updated.Status.CompletionTime)
+	})
+
+	t.Run("Should wait while another Gardener operation is pending", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationMaintain)
+		shoot := fixShoot(nil)
+		shoot.Annotations = map[string]string{v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile}
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, shoot)
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, v1beta1constants.GardenerOperationReconcile, getShoot(t, r).Annotations[v1beta1constants.GardenerOperation])
+
+		updated := getOperation(t, r, operation)
+		assert.Equal(t, imv1.RuntimeOperationStatePending, updated.Status.State)
+		assert.Nil(t, updated.Status.StartTime)
+	})
+
+	t.Run("Should fail retry when the last shoot operation did not fail", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationRetry)
+		shoot := fixShoot(&gardener.LastOperation{State: gardener.LastOperationStateSucceeded})
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, shoot)
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.NotContains(t, getShoot(t, r).Annotations, v1beta1constants.GardenerOperation)
+
+		updated := getOperation(t, r, operation)
+		assert.Equal(t, imv1.RuntimeOperationStateFailed, updated.Status.State)
+		assert.NotEmpty(t, updated.Status.Error)
+		assert.NotNil(t, updated.Status.CompletionTime)
+	})
+
+	t.Run("Should fail when the Runtime does not exist", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationReconcile)
+		r := setupReconciler(t, []client.Object{operation})
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+
+		updated := getOperation(t, r, operation)
+		assert.Equal(t, imv1.RuntimeOperationStateFailed, updated.Status.State)
+		assert.Equal(t, "Runtime runtime-id not found", updated.Status.Error)
+	})
+
+	t.Run("Should keep waiting until the shoot operation started after the request finishes", func(t *testing.T) {
+		// given
+		operation := fixStartedRuntimeOperation(imv1.RuntimeOperationReconcile)
+		shoot := fixShoot(&gardener.LastOperation{
+			State:          gardener.LastOperationStateSucceeded,
+			LastUpdateTime: metav1.NewTime(operation.Status.StartTime.Add(-time.Minute)),
+		})
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, shoot)
+
+		// when
+		result, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ctrl.Result{RequeueAfter: progressCheckInterval}, result)
+		assert.Equal(t, imv1.RuntimeOperationStateInProgress, getOperation(t, r, operation).Status.State)
+	})
+
+	t.Run("Should succeed when the shoot operation succeeded after the request", func(t *testing.T) {
+		// given
+		operation := fixStartedRuntimeOperation(imv1.RuntimeOperationReconcile)
+		shoot := fixShoot(&gardener.LastOperation{
+			State:          gardener.LastOperationStateSucceeded,
+			LastUpdateTime: metav1.NewTime(operation.Status.StartTime.Add(time.Minute)),
+		})
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, shoot)
+
+		// when
+		result, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ctrl.Result{}, result)
+
+		updated := getOperation(t, r, operation)
+		assert.Equal(t, imv1.RuntimeOperationStateSucceeded, updated.Status.State)
+		assert.NotNil(t, updated.Status.CompletionTime)
+		assert.Contains(t, <-r.EventRecorder.(*record.FakeRecorder).Events, eventReasonOperationSucceeded)
+	})
+
+	t.Run("Should fail with the shoot errors when the shoot operation failed", func(t *testing.T) {
+		// given
+		operation := fixStartedRuntimeOperation(imv1.RuntimeOperationRetry)
+		shoot := fixShoot(&gardener.LastOperation{
+			State:          gardener.LastOperationStateFailed,
+			LastUpdateTime: metav1.NewTime(operation.Status.StartTime.Add(time.Minute)),
+		})
+		shoot.Status.LastErrors = []gardener.LastError{{Description: "quota exceeded"}, {Description: "infrastructure error"}}
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, shoot)
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+
+		updated := getOperation(t, r, operation)
+		assert.Equal(t, imv1.RuntimeOperationStateFailed, updated.Status.State)
+		assert.Equal(t, "quota exceeded; infrastructure error", updated.Status.Error)
+		assert.Contains(t, <-r.EventRecorder.(*record.FakeRecorder).Events, eventReasonOperationFailed)
+	})
+
+	t.Run("Should fail when the operation timed out", func(t *testing.T) {
+		// given
+		operation := fixStartedRuntimeOperation(imv1.RuntimeOperationMaintain)
+		operation.Status.StartTime = &metav1.Time{Time: time.Now().Add(-2 * operationTimeout)}
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, fixShoot(nil))
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, imv1.RuntimeOperationStateFailed, getOperation(t, r, operation).Status.State)
+	})
+
+	t.Run("Should request kubeconfig rotation and succeed when the annotation is removed", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationRotateKubeconfig)
+		cluster := &imv1.GardenerCluster{ObjectMeta: metav1.ObjectMeta{Name: "runtime-id", Namespace: testNamespace}}
+		r := setupReconciler(t, []client.Object{operation, fixRuntime(), cluster})
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(cluster), cluster))
+		assert.Equal(t, "true", cluster.Annotations[kubeconfig.ForceKubeconfigRotationAnnotation])
+
+		// when
+		delete(cluster.Annotations, kubeconfig.ForceKubeconfigRotationAnnotation)
+		require.NoError(t, r.Update(context.Background(), cluster))
+		_, err = r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, imv1.RuntimeOperationStateSucceeded, getOperation(t, r, operation).Status.State)
+	})
+
+	t.Run("Should request forced patch of the shoot", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationForcePatch)
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()})
+
+		// when
+		_, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+
+		var rt imv1.Runtime
+		require.NoError(t, r.Get(context.Background(), types.NamespacedName{Name: "runtime-id", Namespace: testNamespace}, &rt))
+		assert.True(t, reconciler.ShouldForceReconciliation(rt.Annotations))
+		assert.Equal(t, imv1.RuntimeOperationStateInProgress, getOperation(t, r, operation).Status.State)
+	})
+
+	t.Run("Should ignore finished operation", func(t *testing.T) {
+		// given
+		operation := fixRuntimeOperation(imv1.RuntimeOperationReconcile)
+		operation.Status.State = imv1.RuntimeOperationStateSucceeded
+		r := setupReconciler(t, []client.Object{operation, fixRuntime()}, fixShoot(nil))
+
+		// when
+		result, err := r.Reconcile(context.Background(), requestFor(operation))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, ctrl.Result{}, result)
+		assert.NotContains(t, getShoot(t, r).Annotations, v1beta1constants.GardenerOperation)
+	})
+}
+
+func setupReconciler(t *testing.T, objs []client.Object, shoots ...client.Object) *RuntimeOperationReconciler {
+	scheme := runtime.NewScheme()
+	require.NoError(t, imv1.AddToScheme(scheme))
+	require.NoError(t, gardener.AddToScheme(scheme))
+
+	return &RuntimeOperationReconciler{
+		Client:         fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).WithStatusSubresource(&imv1.RuntimeOperation{}).Build(),
+		ShootClient:    fake.NewClientBuilder().WithScheme(scheme).WithObjects(shoots...).Build(),
+		ShootNamespace: testShootNamespace,
+		Log:            logr.Discard(),
+		EventRecorder:  record.NewFakeRecorder(1),
+	}
+}
+
+func fixRuntimeOperation(operationType imv1.RuntimeOperationType) *imv1.RuntimeOperation {
+	return &imv1.RuntimeOperation{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-operation", Namespace: testNamespace},
+		Spec: imv1.RuntimeOperationSpec{
+			RuntimeName: "runtime-id",
+			Type:        operationType,
+		},
+	}
+}
+
+func fixStartedRuntimeOperation(operationType imv1.RuntimeOperationType) *imv1.RuntimeOperation {
+	operation := fixRuntimeOperation(operationType)
+	operation.Status.State = imv1.RuntimeOperationStateInProgress
+	operation.Status.StartTime = &metav1.Time{Time: time.Now().Add(-5 * time.Minute).Truncate(time.Second)}
+
+	return operation
+}
+
+func fixRuntime() *imv1.Runtime {
+	return &imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime-id",
+			Namespace: testNamespace,
+			Labels:    map[string]string{imv1.LabelKymaRuntimeID: "runtime-id"},
+		},
+		Spec: imv1.RuntimeSpec{Shoot: imv1.RuntimeShoot{Name: "test-shoot"}},
+	}
+}
+
+func fixShoot(lastOperation *gardener.LastOperation) *gardener.Shoot {
+	return &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: testShootNamespace},
+		Status:     gardener.ShootStatus{LastOperation: lastOperation},
+	}
+}
+
+func requestFor(operation *imv1.RuntimeOperation) ctrl.Request {
+	return ctrl.Request{NamespacedName: client.ObjectKeyFromObject(operation)}
+}
+
+func getOperation(t *testing.T, r *RuntimeOperationReconciler, operation *imv1.RuntimeOperation) imv1.RuntimeOperation {
+	var updated imv1.RuntimeOperation
+	require.NoError(t, r.Get(context.Background(), client.ObjectKeyFromObject(operation), &updated))
+
+	return updated
+}
+
+func getShoot(t *testing.T, r *RuntimeOperationReconciler) gardener.Shoot {
+	var shoot gardener.Shoot
+	require.NoError(t, r.ShootClient.Get(context.Background(), types.NamespacedName{Name: "test-shoot", Namespace: testShootNamespace}, &shoot))
+
+	return shoot
+}
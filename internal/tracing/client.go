@@ -0,0 +1,151 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tracedClient creates a span for every API call of the wrapped client
+type tracedClient struct {
+	client.Client
+	target string
+}
+
+// NewClient wraps the client, so every API call becomes a span named "<target> <verb> <kind>", e.g. "gardener Get Shoot"
+func NewClient(c client.Client, target string) client.Client {
+	return &tracedClient{Client: c, target: target}
+}
+
+func (c *tracedClient) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) (err error) {
+	ctx, span := c.start(ctx, "Get", c.kind(obj), key.Namespace, key.Name)
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+func (c *tracedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) (err error) {
+	kind := "List"
+	if gvk, gvkErr := c.GroupVersionKindFor(list); gvkErr == nil {
+		kind = gvk.Kind
+	}
+
+	ctx, span := c.start(ctx, "List", kind, "", "")
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.List(ctx, list, opts...)
+}
+
+func (c *tracedClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) (err error) {
+	ctx, span := c.start(ctx, "Create", c.kind(obj), obj.GetNamespace(), obj.GetName())
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.Create(ctx, obj, opts...)
+}
+
+func (c *tracedClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) (err error) {
+	ctx, span := c.start(ctx, "Delete", c.kind(obj), obj.GetNamespace(), obj.GetName())
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.Delete(ctx, obj, opts...)
+}
+
+func (c *tracedClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) (err error) {
+	ctx, span := c.start(ctx, "Update", c.kind(obj), obj.GetNamespace(), obj.GetName())
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func (c *tracedClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) (err error) {
+	ctx, span := c.start(ctx, "Patch", c.kind(obj), obj.GetNamespace(), obj.GetName())
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *tracedClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) (err error) {
+	ctx, span := c.start(ctx, "DeleteAllOf", c.kind(obj), "", "")
+	defer func() { EndSpan(span, err) }()
+
+	return c.Client.DeleteAllOf(ctx, obj, opts...)
+}
+
+func (c *tracedClient) Status() client.SubResourceWriter {
+	return c.SubResource("status")
+}
+
+func (c *tracedClient) SubResource(subResource string) client.SubResourceClient {
+	return &tracedSubResourceClient{
+		SubResourceClient: c.Client.SubResource(subResource),
+		target:            c.target,
+		subResource:       subResource,
+		kindFor:           c.kind,
+	}
+}
+
+func (c *tracedClient) kind(obj client.Object) string {
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return fmt.Sprintf("%T", obj)
+	}
+	return gvk.Kind
+}
+
+func (c *tracedClient) start(ctx context.Context, verb, kind, namespace, name string) (context.Context, trace.Span) {
+	return startSpan(ctx, c.target, verb, kind, namespace, name)
+}
+
+// tracedSubResourceClient creates a span for every write of the wrapped subresource client
+type tracedSubResourceClient struct {
+	client.SubResourceClient
+	target      string
+	subResource string
+	kindFor     func(obj client.Object) string
+}
+
+func (c *tracedSubResourceClient) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) (err error) {
+	ctx, span := c.start(ctx, "Create", obj)
+	defer func() { EndSpan(span, err) }()
+
+	return c.SubResourceClient.Create(ctx, obj, subResource, opts...)
+}
+
+func (c *tracedSubResourceClient) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) (err error) {
+	ctx, span := c.start(ctx, "Update", obj)
+	defer func() { EndSpan(span, err) }()
+
+	return c.SubResourceClient.Update(ctx, obj, opts...)
+}
+
+func (c *tracedSubResourceClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) (err error) {
+	ctx, span := c.start(ctx, "Patch", obj)
+	defer func() { EndSpan(span, err) }()
+
+	return c.SubResourceClient.Patch(ctx, obj, patch, opts...)
+}
+
+func (c *tracedSubResourceClient) start(ctx context.Context, verb string, obj client.Object) (context.Context, trace.Span) {
+	return startSpan(ctx, c.target, verb, c.kindFor(obj)+"/"+c.subResource, obj.GetNamespace(), obj.GetName())
+}
+
+func startSpan(ctx context.Context, target, verb, kind, namespace, name string) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		attribute.String("k8s.target", target),
+		attribute.String("k8s.kind", kind),
+	}
+	if namespace != "" {
+		attributes = append(attributes, attribute.String("k8s.namespace", namespace))
+	}
+	if name != "" {
+		attributes = append(attributes, attribute.String("k8s.name", name))
+	}
+
+	return Tracer().Start(ctx, fmt.Sprintf("%s %s %s", target, verb, kind),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+}
@@ -0,0 +1,86 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestTracedClient(t *testing.T) {
+	exporter := setupInMemoryExporter(t)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, gardener.AddToScheme(scheme))
+	shoot := &gardener.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-shoot", Namespace: "garden-test"},
+	}
+	tracedClient := NewClient(fake.NewClientBuilder().WithScheme(scheme).WithObjects(shoot).WithStatusSubresource(shoot).Build(), "gardener")
+
+	t.Run("Should create span for API call", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		var result gardener.Shoot
+
+		// when
+		err := tracedClient.Get(context.Background(), types.NamespacedName{Name: "test-shoot", Namespace: "garden-test"}, &result)
+
+		// then
+		require.NoError(t, err)
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "gardener Get Shoot", spans[0].Name)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+	})
+
+	t.Run("Should mark span as failed when API call fails", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		var result gardener.Shoot
+
+		// when
+		err := tracedClient.Get(context.Background(), types.NamespacedName{Name: "missing-shoot", Namespace: "garden-test"}, &result)
+
+		// then
+		require.Error(t, err)
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+	})
+
+	t.Run("Should create child spans for status updates", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		ctx, parent := Tracer().Start(context.Background(), "parent")
+
+		// when
+		err := tracedClient.Status().Update(ctx, shoot.DeepCopy())
+		parent.End()
+
+		// then
+		require.NoError(t, err)
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, "gardener Update Shoot/status", spans[0].Name)
+		assert.Equal(t, spans[1].SpanContext.SpanID(), spans[0].Parent.SpanID())
+	})
+}
+
+func setupInMemoryExporter(t *testing.T) *tracetest.InMemoryExporter {
+	exporter := tracetest.NewInMemoryExporter()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	return exporter
+}
@@ -0,0 +1,113 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/configwatch"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/provider"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+//+kubebuilder:webhook:path=/mutate-infrastructuremanager-kyma-project-io-v1-runtime,mutating=true,failurePolicy=fail,sideEffects=None,groups=infrastructuremanager.kyma-project.io,resources=runtimes,verbs=create;update,versions=v1,name=mruntime-v1.kb.io,admissionReviewVersions=v1
+
+// RuntimeCustomDefaulter writes the values which the shoot converter would otherwise default silently into the Runtime spec.
+// Only fields which are not set are defaulted, so the values provided by the user are never overwritten.
+// The spec is defaulted only on creation, so the updates don't fill in the fields cleared by the user.
+type RuntimeCustomDefaulter struct {
+	Config config.Config
+	// ConfigWatcher provides the current configuration, Config is used as is when not set
+	ConfigWatcher *configwatch.Watcher
+}
+
+var _ webhook.CustomDefaulter = &RuntimeCustomDefaulter{}
+
+// Default implements webhook.CustomDefaulter so a webhook will be registered for the type Runtime.
+func (d *RuntimeCustomDefaulter) Default(ctx context.Context, obj runtime.Object) error {
+	rt, ok := obj.(*imv1.Runtime)
+	if !ok {
+		return fmt.Errorf("expected a Runtime object but got %T", obj)
+	}
+
+	if !rt.GetDeletionTimestamp().IsZero() {
+		return nil
+	}
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	runtimelog.Info("Defaulting for Runtime", "name", rt.GetName(), "operation", req.Operation)
+
+	if err := defaultDeletionProtection(rt, req); err != nil {
+		return err
+	}
+
+	if req.Operation == admissionv1.Create {
+		d.applyDefaults(rt)
+	}
+
+	return nil
+}
+
+// defaultDeletionProtection protects production runtimes against deletion, unless the protection was explicitly configured,
+// or removed from the Runtime by the update
+func defaultDeletionProtection(rt *imv1.Runtime, req admission.Request) error {
+	if rt.Spec.Shoot.Purpose != gardener.ShootPurposeProduction {
+		return nil
+	}
+
+	if _, found := rt.Annotations[reconciler.DeletionProtectionAnnotation]; found {
+		return nil
+	}
+
+	if req.Operation == admissionv1.Update {
+		var oldRuntime imv1.Runtime
+		if err := json.Unmarshal(req.OldObject.Raw, &oldRuntime); err != nil {
+			return fmt.Errorf("failed to decode the old Runtime object: %w", err)
+		}
+
+		if _, found := oldRuntime.Annotations[reconciler.DeletionProtectionAnnotation]; found {
+			return nil
+		}
+	}
+
+	metav1.SetMetaDataAnnotation(&rt.ObjectMeta, reconciler.DeletionProtectionAnnotation, "true")
+	return nil
+}
+
+func (d *RuntimeCustomDefaulter) applyDefaults(rt *imv1.Runtime) {
+	cfg := d.currentConfig()
+	converterConfig := cfg.ConverterConfig
+
+	kubernetesVersion := rt.Spec.Shoot.Kubernetes.Version
+	if kubernetesVersion == nil || *kubernetesVersion == "" {
+		rt.Spec.Shoot.Kubernetes.Version = ptr.To(converterConfig.Kubernetes.DefaultVersion)
+	}
+
+	provider.DefaultWorkersMachineImage(rt.Spec.Shoot.Provider.Workers, converterConfig.MachineImage.DefaultName, converterConfig.MachineImage.DefaultVersion)
+	if rt.Spec.Shoot.Provider.AdditionalWorkers != nil {
+		provider.DefaultWorkersMachineImage(*rt.Spec.Shoot.Provider.AdditionalWorkers, converterConfig.MachineImage.DefaultName, converterConfig.MachineImage.DefaultVersion)
+	}
+
+	extender.DefaultOidcConfigIfNotPresent(rt, converterConfig.Kubernetes.DefaultOperatorOidc)
+	extender.DefaultAdditionalOidcIfNotPresent(rt, cfg.ClusterConfig.DefaultSharedIASTenant)
+}
+
+func (d *RuntimeCustomDefaulter) currentConfig() config.Config {
+	if d.ConfigWatcher == nil {
+		return d.Config
+	}
+	return d.ConfigWatcher.Snapshot().Config
+}
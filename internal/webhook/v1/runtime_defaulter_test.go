@@ -0,0 +1,203 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func TestRuntimeDefaulter(t *testing.T) {
+	defaulter := RuntimeCustomDefaulter{Config: fixConfig()}
+
+	t.Run("Should materialize converter defaults in Runtime spec", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Spec.Shoot.Provider.AdditionalWorkers = &[]gardener.Worker{fixWorker("additional", "eu-central-1a")}
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Create, nil), &rt)
+
+		// then
+		require.NoError(t, err)
+		kubeAPIServer := rt.Spec.Shoot.Kubernetes.KubeAPIServer
+		assert.Equal(t, "1.30", *rt.Spec.Shoot.Kubernetes.Version)
+		assert.Equal(t, "gardenlinux", rt.Spec.Shoot.Provider.Workers[0].Machine.Image.Name)
+		assert.Equal(t, "1592.1.0", *rt.Spec.Shoot.Provider.Workers[0].Machine.Image.Version)
+		assert.Equal(t, "gardenlinux", (*rt.Spec.Shoot.Provider.AdditionalWorkers)[0].Machine.Image.Name)
+		assert.Equal(t, "1592.1.0", *(*rt.Spec.Shoot.Provider.AdditionalWorkers)[0].Machine.Image.Version)
+		assert.Equal(t, "operator-client-id", *kubeAPIServer.OidcConfig.ClientID)
+		assert.Equal(t, "https://operator.example.com", *kubeAPIServer.OidcConfig.IssuerURL)
+		require.NotNil(t, kubeAPIServer.AdditionalOidcConfig)
+		require.Len(t, *kubeAPIServer.AdditionalOidcConfig, 1)
+		assert.Equal(t, "ias-client-id", *(*kubeAPIServer.AdditionalOidcConfig)[0].ClientID)
+		assert.Equal(t, "https://ias.example.com", *(*kubeAPIServer.AdditionalOidcConfig)[0].IssuerURL)
+	})
+
+	t.Run("Should not overwrite values specified in Runtime", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Spec.Shoot.Kubernetes.Version = ptr.To("1.31")
+		rt.Spec.Shoot.Provider.Workers[0].Machine.Image = &gardener.ShootMachineImage{Name: "ubuntu"}
+		rt.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = gardener.OIDCConfig{
+			ClientID:  ptr.To("custom-client-id"),
+			IssuerURL: ptr.To("https://custom.example.com"),
+		}
+		rt.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = &[]imv1.OIDCConfig{
+			{OIDCConfig: gardener.OIDCConfig{
+				ClientID:  ptr.To("additional-client-id"),
+				IssuerURL: ptr.To("https://additional.example.com"),
+			}},
+		}
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Create, nil), &rt)
+
+		// then
+		require.NoError(t, err)
+		kubeAPIServer := rt.Spec.Shoot.Kubernetes.KubeAPIServer
+		assert.Equal(t, "1.31", *rt.Spec.Shoot.Kubernetes.Version)
+		assert.Equal(t, "ubuntu", rt.Spec.Shoot.Provider.Workers[0].Machine.Image.Name)
+		assert.Equal(t, "1592.1.0", *rt.Spec.Shoot.Provider.Workers[0].Machine.Image.Version)
+		assert.Equal(t, "custom-client-id", *kubeAPIServer.OidcConfig.ClientID)
+		require.Len(t, *kubeAPIServer.AdditionalOidcConfig, 1)
+		assert.Equal(t, "additional-client-id", *(*kubeAPIServer.AdditionalOidcConfig)[0].ClientID)
+	})
+
+	t.Run("Should protect production Runtime against deletion", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Spec.Shoot.Purpose = gardener.ShootPurposeProduction
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Create, nil), &rt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "true", rt.Annotations[reconciler.DeletionProtectionAnnotation])
+	})
+
+	t.Run("Should not overwrite deletion protection of production Runtime", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Spec.Shoot.Purpose = gardener.ShootPurposeProduction
+		rt.Annotations = map[string]string{reconciler.DeletionProtectionAnnotation: "false"}
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Create, nil), &rt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "false", rt.Annotations[reconciler.DeletionProtectionAnnotation])
+	})
+
+	t.Run("Should not default Runtime spec on update", func(t *testing.T) {
+		// given
+		oldRt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Update, &oldRt), &rt)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, rt.Spec.Shoot.Kubernetes.Version)
+		assert.Nil(t, rt.Spec.Shoot.Provider.Workers[0].Machine.Image)
+		assert.Nil(t, rt.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig.ClientID)
+		assert.Nil(t, rt.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig)
+	})
+
+	t.Run("Should protect production Runtime without deletion protection on update", func(t *testing.T) {
+		// given
+		oldRt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		oldRt.Spec.Shoot.Purpose = gardener.ShootPurposeProduction
+		rt := oldRt
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Update, &oldRt), &rt)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "true", rt.Annotations[reconciler.DeletionProtectionAnnotation])
+	})
+
+	t.Run("Should not add deletion protection removed from production Runtime", func(t *testing.T) {
+		// given
+		oldRt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		oldRt.Spec.Shoot.Purpose = gardener.ShootPurposeProduction
+		oldRt.Annotations = map[string]string{reconciler.DeletionProtectionAnnotation: "false"}
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Spec.Shoot.Purpose = gardener.ShootPurposeProduction
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Update, &oldRt), &rt)
+
+		// then
+		require.NoError(t, err)
+		assert.NotContains(t, rt.Annotations, reconciler.DeletionProtectionAnnotation)
+	})
+
+	t.Run("Should not protect evaluation Runtime against deletion", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Spec.Shoot.Purpose = gardener.ShootPurposeEvaluation
+
+		// when
+		err := defaulter.Default(fixAdmissionContext(admissionv1.Create, nil), &rt)
+
+		// then
+		require.NoError(t, err)
+		assert.NotContains(t, rt.Annotations, reconciler.DeletionProtectionAnnotation)
+	})
+}
+
+func fixAdmissionContext(operation admissionv1.Operation, oldRt *imv1.Runtime) context.Context {
+	req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{Operation: operation}}
+	if oldRt != nil {
+		raw, _ := json.Marshal(oldRt)
+		req.OldObject = runtime.RawExtension{Raw: raw}
+	}
+	return admission.NewContextWithRequest(context.Background(), req)
+}
+
+func fixConfig() config.Config {
+	return config.Config{
+		ConverterConfig: config.ConverterConfig{
+			Kubernetes: config.KubernetesConfig{
+				DefaultVersion: "1.30",
+				DefaultOperatorOidc: config.OidcProvider{
+					ClientID:       "operator-client-id",
+					GroupsClaim:    "groups",
+					IssuerURL:      "https://operator.example.com",
+					SigningAlgs:    []string{"RS256"},
+					UsernameClaim:  "sub",
+					UsernamePrefix: "-",
+				},
+			},
+			MachineImage: config.MachineImageConfig{
+				DefaultName:    "gardenlinux",
+				DefaultVersion: "1592.1.0",
+			},
+		},
+		ClusterConfig: config.ClusterConfig{
+			DefaultSharedIASTenant: config.OidcProvider{
+				ClientID:       "ias-client-id",
+				GroupsClaim:    "groups",
+				IssuerURL:      "https://ias.example.com",
+				SigningAlgs:    []string{"RS256"},
+				UsernameClaim:  "sub",
+				UsernamePrefix: "-",
+			},
+		},
+	}
+}
@@ -0,0 +1,215 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/internal/configwatch"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/provider"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+//nolint:gochecknoglobals
+var runtimelog = logf.Log.WithName("runtime-resource")
+
+// SetupRuntimeWebhookWithManager registers the webhooks for Runtime in the manager, the defaults are taken from the current configuration of the watcher.
+func SetupRuntimeWebhookWithManager(mgr ctrl.Manager, configWatcher *configwatch.Watcher) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&imv1.Runtime{}).
+		WithValidator(&RuntimeCustomValidator{}).
+		WithDefaulter(&RuntimeCustomDefaulter{ConfigWatcher: configWatcher}).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-infrastructuremanager-kyma-project-io-v1-runtime,mutating=false,failurePolicy=fail,sideEffects=None,groups=infrastructuremanager.kyma-project.io,resources=runtimes,verbs=create;update;delete,versions=v1,name=vruntime-v1.kb.io,admissionReviewVersions=v1
+
+// RuntimeCustomValidator rejects Runtime CRs which would fail later on during the shoot conversion.
+// It also rejects the deletion of protected Runtime CRs, and the removal of the protection without the unlock.
+type RuntimeCustomValidator struct{}
+
+var _ webhook.CustomValidator = &RuntimeCustomValidator{}
+
+// ValidateCreate implements webhook.CustomValidator so a webhook will be registered for the type Runtime.
+func (v *RuntimeCustomValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rt, ok := obj.(*imv1.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("expected a Runtime object but got %T", obj)
+	}
+	runtimelog.Info("Validation for Runtime upon creation", "name", rt.GetName())
+
+	return nil, toInvalidError(rt, ValidateRuntime(rt))
+}
+
+// ValidateUpdate implements webhook.CustomValidator so a webhook will be registered for the type Runtime.
+func (v *RuntimeCustomValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldRt, ok := oldObj.(*imv1.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("expected a Runtime object for the oldObj but got %T", oldObj)
+	}
+
+	rt, ok := newObj.(*imv1.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("expected a Runtime object for the newObj but got %T", newObj)
+	}
+	runtimelog.Info("Validation for Runtime upon update", "name", rt.GetName())
+
+	if allErrs := validateDeletionProtectionChange(oldRt, rt); len(allErrs) > 0 {
+		return nil, toInvalidError(rt, allErrs)
+	}
+
+	// Runtimes being deleted must be always updatable, otherwise the finalizer could not be removed.
+	// Specs which were not changed are not validated to keep the existing runtimes manageable.
+	if !rt.GetDeletionTimestamp().IsZero() || !specOrLabelsChanged(oldRt, rt) {
+		return nil, nil
+	}
+
+	return nil, toInvalidError(rt, ValidateRuntime(rt))
+}
+
+// ValidateDelete implements webhook.CustomValidator so a webhook will be registered for the type Runtime.
+func (v *RuntimeCustomValidator) ValidateDelete(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	rt, ok := obj.(*imv1.Runtime)
+	if !ok {
+		return nil, fmt.Errorf("expected a Runtime object but got %T", obj)
+	}
+
+	if reconciler.ShouldProtectDeletion(rt.Annotations) {
+		runtimelog.Info("Deletion of protected Runtime rejected", "name", rt.GetName())
+		return nil, apierrors.NewForbidden(imv1.GroupVersion.WithResource("runtimes").GroupResource(), rt.Name,
+			fmt.Errorf("runtime is protected against deletion, set the %s annotation to true and then the %s annotation to false to remove the protection",
+				reconciler.DeletionProtectionUnlockAnnotation, reconciler.DeletionProtectionAnnotation))
+	}
+
+	return nil, nil
+}
+
+// ValidateRuntime performs the same checks as the shoot converter, so that invalid specs are rejected at admission time.
+func ValidateRuntime(rt *imv1.Runtime) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := rt.ValidateRequiredLabels(); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "labels"), rt.Labels, err.Error()))
+	}
+
+	providerPath := field.NewPath("spec", "shoot", "provider")
+	runtimeShoot := rt.Spec.Shoot
+
+	if err := provider.ValidateProviderType(runtimeShoot.Provider.Type); err != nil {
+		allErrs = append(allErrs, field.NotSupported(providerPath.Child("type"), runtimeShoot.Provider.Type, supportedProviders()))
+	}
+
+	if err := provider.ValidateMainWorker(runtimeShoot.Provider.Workers); err != nil {
+		allErrs = append(allErrs, field.Invalid(providerPath.Child("workers"), len(runtimeShoot.Provider.Workers), err.Error()))
+	}
+
+	if err := provider.ValidateNetworkingZones(runtimeShoot); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "shoot", "networking", "nodes"), runtimeShoot.Networking.Nodes, err.Error()))
+	}
+
+	if runtimeShoot.Hibernation != nil {
+		allErrs = append(allErrs, validateHibernation(runtimeShoot.Hibernation)...)
+	}
+
+	return allErrs
+}
+
+// validateHibernation rejects the schedules combined with the enabled field, Gardener changes the field according to the schedules
+// and the Runtime Controller would revert the change with the next patch
+func validateHibernation(hibernation *gardener.Hibernation) field.ErrorList {
+	var allErrs field.ErrorList
+
+	hibernationPath := field.NewPath("spec", "shoot", "hibernation")
+	if hibernation.Enabled != nil && len(hibernation.Schedules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(hibernationPath.Child("enabled"), "enabled must not be set together with the hibernation schedules"))
+	}
+
+	schedulesPath := hibernationPath.Child("schedules")
+	for i, schedule := range hibernation.Schedules {
+		if schedule.Start == nil && schedule.End == nil {
+			allErrs = append(allErrs, field.Required(schedulesPath.Index(i), "start or end of the hibernation schedule must be set"))
+		}
+		if schedule.Start != nil {
+			if _, err := cron.ParseStandard(*schedule.Start); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulesPath.Index(i).Child("start"), *schedule.Start, err.Error()))
+			}
+		}
+		if schedule.End != nil {
+			if _, err := cron.ParseStandard(*schedule.End); err != nil {
+				allErrs = append(allErrs, field.Invalid(schedulesPath.Index(i).Child("end"), *schedule.End, err.Error()))
+			}
+		}
+		if schedule.Location == nil {
+			continue
+		}
+		if _, err := time.LoadLocation(*schedule.Location); err != nil {
+			allErrs = append(allErrs, field.Invalid(schedulesPath.Index(i).Child("location"), *schedule.Location, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateDeletionProtectionChange allows removing the deletion protection only in two steps,
+// the unlock annotation must be set in a previous update and removed together with the protection
+func validateDeletionProtectionChange(oldRt, rt *imv1.Runtime) field.ErrorList {
+	if !reconciler.ShouldProtectDeletion(oldRt.Annotations) || reconciler.ShouldProtectDeletion(rt.Annotations) {
+		return nil
+	}
+
+	annotationsPath := field.NewPath("metadata", "annotations")
+	if !reconciler.IsDeletionProtectionUnlocked(oldRt.Annotations) {
+		return field.ErrorList{field.Forbidden(annotationsPath.Key(reconciler.DeletionProtectionAnnotation),
+			fmt.Sprintf("deletion protection can be removed only after the %s annotation is set to true", reconciler.DeletionProtectionUnlockAnnotation))}
+	}
+
+	if reconciler.IsDeletionProtectionUnlocked(rt.Annotations) {
+		return field.ErrorList{field.Forbidden(annotationsPath.Key(reconciler.DeletionProtectionUnlockAnnotation),
+			"unlock annotation must be removed together with the deletion protection")}
+	}
+
+	return nil
+}
+
+func supportedProviders() []string {
+	return []string{hyperscaler.TypeAWS, hyperscaler.TypeAzure, hyperscaler.TypeGCP, hyperscaler.TypeOpenStack}
+}
+
+func specOrLabelsChanged(oldRt, rt *imv1.Runtime) bool {
+	return !reflect.DeepEqual(oldRt.Spec, rt.Spec) || !reflect.DeepEqual(oldRt.Labels, rt.Labels)
+}
+
+func toInvalidError(rt *imv1.Runtime, allErrs field.ErrorList) error {
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(imv1.GroupVersion.WithKind("Runtime").GroupKind(), rt.Name, allErrs)
+}
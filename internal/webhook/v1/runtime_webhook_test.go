@@ -0,0 +1,321 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/reconciler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRuntimeValidator(t *testing.T) {
+	validator := RuntimeCustomValidator{}
+
+	t.Run("Should accept valid Runtime", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a", "eu-central-1b", "eu-central-1c")
+
+		// when
+		_, err := validator.ValidateCreate(context.Background(), &rt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should accept Runtime with hibernation schedules", func(t *testing.T) {
+		// given
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a", "eu-central-1b", "eu-central-1c")
+		rt.Spec.Shoot.Hibernation = &gardener.Hibernation{
+			Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5"), End: ptr.To("00 08 * * 1,2,3,4,5"), Location: ptr.To("Europe/Berlin")}},
+		}
+
+		// when
+		_, err := validator.ValidateCreate(context.Background(), &rt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	for tname, tcase := range map[string]struct {
+		modify        func(rt *imv1.Runtime)
+		expectedField string
+	}{
+		"Should reject Runtime without required labels": {
+			modify: func(rt *imv1.Runtime) {
+				delete(rt.Labels, imv1.LabelKymaGlobalAccountID)
+			},
+			expectedField: "metadata.labels",
+		},
+		"Should reject Runtime with unsupported provider": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Provider.Type = "alicloud"
+			},
+			expectedField: "spec.shoot.provider.type",
+		},
+		"Should reject Runtime without main worker": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Provider.Workers = nil
+			},
+			expectedField: "spec.shoot.provider.workers",
+		},
+		"Should reject Runtime with more than one main worker": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Provider.Workers = append(rt.Spec.Shoot.Provider.Workers, fixWorker("additional", "eu-central-1a"))
+			},
+			expectedField: "spec.shoot.provider.workers",
+		},
+		"Should reject AWS Runtime with too small nodes CIDR prefix": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Networking.Nodes = "10.250.0.0/25"
+			},
+			expectedField: "spec.shoot.networking.nodes",
+		},
+		"Should reject AWS Runtime with too many zones": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Provider.AdditionalWorkers = &[]gardener.Worker{
+					fixWorker("additional", "a", "b", "c", "d", "e", "f"),
+				}
+			},
+			expectedField: "spec.shoot.networking.nodes",
+		},
+		"Should reject Azure Runtime with invalid zone name": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Provider.Type = "azure"
+				rt.Spec.Shoot.Provider.Workers = []gardener.Worker{fixWorker("main", "1", "9")}
+			},
+			expectedField: "spec.shoot.networking.nodes",
+		},
+		"Should reject Runtime with hibernation schedule in unknown time zone": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Hibernation = &gardener.Hibernation{
+					Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 20 * * *"), Location: ptr.To("Europe/Atlantis")}},
+				}
+			},
+			expectedField: "spec.shoot.hibernation.schedules[0].location",
+		},
+		"Should reject Runtime with empty hibernation schedule": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Hibernation = &gardener.Hibernation{
+					Schedules: []gardener.HibernationSchedule{{Location: ptr.To("Europe/Berlin")}},
+				}
+			},
+			expectedField: "spec.shoot.hibernation.schedules[0]",
+		},
+		"Should reject Runtime with hibernation enabled together with schedules": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Hibernation = &gardener.Hibernation{
+					Enabled:   ptr.To(true),
+					Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 20 * * 1,2,3,4,5")}},
+				}
+			},
+			expectedField: "spec.shoot.hibernation.enabled",
+		},
+		"Should reject Runtime with invalid hibernation schedule start": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Hibernation = &gardener.Hibernation{
+					Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 25 * * *"), End: ptr.To("00 08 * * *")}},
+				}
+			},
+			expectedField: "spec.shoot.hibernation.schedules[0].start",
+		},
+		"Should reject Runtime with invalid hibernation schedule end": {
+			modify: func(rt *imv1.Runtime) {
+				rt.Spec.Shoot.Hibernation = &gardener.Hibernation{
+					Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 20 * * *"), End: ptr.To("every morning")}},
+				}
+			},
+			expectedField: "spec.shoot.hibernation.schedules[0].end",
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a", "eu-central-1b", "eu-central-1c")
+			tcase.modify(&rt)
+
+			// when
+			_, err := validator.ValidateCreate(context.Background(), &rt)
+
+			// then
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), tcase.expectedField)
+		})
+	}
+
+	t.Run("Should not validate update which does not change spec or labels", func(t *testing.T) {
+		// given
+		oldRt := fixRuntime("aws", "10.250.0.0/25", "eu-central-1a")
+		rt := oldRt.DeepCopy()
+		rt.Annotations = map[string]string{"operator.kyma-project.io/force-patch-reconciliation": "true"}
+
+		// when
+		_, err := validator.ValidateUpdate(context.Background(), &oldRt, rt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should reject update which makes spec invalid", func(t *testing.T) {
+		// given
+		oldRt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt := oldRt.DeepCopy()
+		rt.Spec.Shoot.Networking.Nodes = "10.250.0.0/25"
+
+		// when
+		_, err := validator.ValidateUpdate(context.Background(), &oldRt, rt)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apierrors.IsInvalid(err))
+	})
+
+	t.Run("Should not validate Runtime being deleted", func(t *testing.T) {
+		// given
+		oldRt := fixRuntime("aws", "10.250.0.0/25", "eu-central-1a")
+		rt := oldRt.DeepCopy()
+		rt.DeletionTimestamp = &metav1.Time{}
+		rt.Finalizers = nil
+
+		// when
+		_, err := validator.ValidateUpdate(context.Background(), &oldRt, rt)
+
+		// then
+		require.NoError(t, err)
+	})
+}
+
+func TestRuntimeDeletionProtection(t *testing.T) {
+	validator := RuntimeCustomValidator{}
+
+	fixProtectedRuntime := func(annotations map[string]string) imv1.Runtime {
+		rt := fixRuntime("aws", "10.250.0.0/16", "eu-central-1a")
+		rt.Annotations = annotations
+		return rt
+	}
+
+	t.Run("Should reject deletion of protected Runtime", func(t *testing.T) {
+		// given
+		rt := fixProtectedRuntime(map[string]string{reconciler.DeletionProtectionAnnotation: "true"})
+
+		// when
+		_, err := validator.ValidateDelete(context.Background(), &rt)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, apierrors.IsForbidden(err))
+	})
+
+	t.Run("Should accept deletion of not protected Runtime", func(t *testing.T) {
+		// given
+		rt := fixProtectedRuntime(map[string]string{reconciler.DeletionProtectionAnnotation: "false"})
+
+		// when
+		_, err := validator.ValidateDelete(context.Background(), &rt)
+
+		// then
+		require.NoError(t, err)
+	})
+
+	for tname, tcase := range map[string]struct {
+		oldAnnotations map[string]string
+		newAnnotations map[string]string
+		expectedError  bool
+	}{
+		"Should reject removing protection without unlock": {
+			oldAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true"},
+			newAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "false"},
+			expectedError:  true,
+		},
+		"Should reject removing protection together with unlock": {
+			oldAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true"},
+			newAnnotations: map[string]string{reconciler.DeletionProtectionUnlockAnnotation: "true"},
+			expectedError:  true,
+		},
+		"Should reject removing protection which keeps the unlock": {
+			oldAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true", reconciler.DeletionProtectionUnlockAnnotation: "true"},
+			newAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "false", reconciler.DeletionProtectionUnlockAnnotation: "true"},
+			expectedError:  true,
+		},
+		"Should accept unlock": {
+			oldAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true"},
+			newAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true", reconciler.DeletionProtectionUnlockAnnotation: "true"},
+		},
+		"Should accept removing protection after unlock": {
+			oldAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true", reconciler.DeletionProtectionUnlockAnnotation: "true"},
+			newAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "false"},
+		},
+		"Should accept enabling protection": {
+			newAnnotations: map[string]string{reconciler.DeletionProtectionAnnotation: "true"},
+		},
+	} {
+		t.Run(tname, func(t *testing.T) {
+			// given
+			oldRt := fixProtectedRuntime(tcase.oldAnnotations)
+			rt := fixProtectedRuntime(tcase.newAnnotations)
+
+			// when
+			_, err := validator.ValidateUpdate(context.Background(), &oldRt, &rt)
+
+			// then
+			if !tcase.expectedError {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.True(t, apierrors.IsInvalid(err))
+			assert.Contains(t, err.Error(), "metadata.annotations")
+		})
+	}
+}
+
+func fixWorker(name string, zones ...string) gardener.Worker {
+	return gardener.Worker{
+		Name:  name,
+		Zones: zones,
+		Machine: gardener.Machine{
+			Type: "m6i.large",
+		},
+		Minimum: 1,
+		Maximum: 3,
+	}
+}
+
+func fixRuntime(providerType, nodesCIDR string, zones ...string) imv1.Runtime {
+	return imv1.Runtime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "runtime-id",
+			Namespace: "kcp-system",
+			Labels: map[string]string{
+				imv1.LabelKymaInstanceID:      "instance-id",
+				imv1.LabelKymaRuntimeID:       "runtime-id",
+				imv1.LabelKymaRegion:          "eu-central-1",
+				imv1.LabelKymaName:            "kyma-name",
+				imv1.LabelKymaBrokerPlanID:    "broker-plan-id",
+				imv1.LabelKymaBrokerPlanName:  "broker-plan-name",
+				imv1.LabelKymaGlobalAccountID: "global-account-id",
+				imv1.LabelKymaSubaccountID:    "subaccount-id",
+			},
+		},
+		Spec: imv1.RuntimeSpec{
+			Shoot: imv1.RuntimeShoot{
+				Name:   "test-shoot",
+				Region: "eu-central-1",
+				Provider: imv1.Provider{
+					Type:    providerType,
+					Workers: []gardener.Worker{fixWorker("main", zones...)},
+				},
+				Networking: imv1.Networking{
+					Nodes:    nodesCIDR,
+					Pods:     "100.64.0.0/12",
+					Services: "100.104.0.0/13",
+				},
+			},
+		},
+	}
+}
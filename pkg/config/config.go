@@ -5,11 +5,117 @@ import (
 	"io"
 
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type Config struct {
-	ConverterConfig ConverterConfig `json:"converter" validate:"required"`
-	ClusterConfig   ClusterConfig   `json:"cluster" validate:"required"`
+	ConverterConfig     ConverterConfig     `json:"converter" validate:"required"`
+	ClusterConfig       ClusterConfig       `json:"cluster" validate:"required"`
+	BackoffConfig       BackoffConfig       `json:"backoff"`
+	TimeoutsConfig      TimeoutsConfig      `json:"timeouts"`
+	DriftDetection      DriftDetection      `json:"driftDetection"`
+	RetryPolicy         RetryPolicy         `json:"retryPolicy"`
+	SoftDelete          SoftDelete          `json:"softDelete"`
+	Expiration          Expiration          `json:"expiration"`
+	CredentialsRotation CredentialsRotation `json:"credentialsRotation"`
+}
+
+// CredentialsRotation defines how often the shoot credentials of Ready runtimes are rotated with the Gardener rotation operations
+type CredentialsRotation struct {
+	Enabled bool `json:"enabled"`
+	// Interval between the rotations of the same credentials, measured from the last completed rotation or the shoot creation
+	Interval metav1.Duration `json:"interval"`
+	// Credentials which are rotated, all credentials are rotated when not set. The rotation requested with the annotation rotates the same credentials
+	Credentials []CredentialsType `json:"credentials,omitempty" validate:"dive,oneof=certificateAuthorities serviceAccountKey etcdEncryptionKey observability"`
+	// RotationsPerMinute limits the number of scheduled rotations started for all runtimes, the rotations are not limited when not set
+	RotationsPerMinute int `json:"rotationsPerMinute" validate:"gte=0"`
+}
+
+type CredentialsType string
+
+const (
+	CredentialsCertificateAuthorities CredentialsType = "certificateAuthorities"
+	CredentialsServiceAccountKey      CredentialsType = "serviceAccountKey"
+	CredentialsETCDEncryptionKey      CredentialsType = "etcdEncryptionKey"
+	CredentialsObservability          CredentialsType = "observability"
+)
+
+// Expiration defines when the runtimes with the expiration time set are warned about the upcoming deletion
+type Expiration struct {
+	// WarningLeadTimes before the expiration time at which the Expiring condition and the warning event are set, e.g. 72h and 24h
+	WarningLeadTimes []metav1.Duration `json:"warningLeadTimes,omitempty"`
+}
+
+// SoftDelete defines how long the shoot of the Runtime with the requested deletion is kept hibernated before the Runtime is deleted
+type SoftDelete struct {
+	Enabled bool `json:"enabled"`
+	// GracePeriod between the deletion request and the Runtime deletion, the Runtime can be restored during this time
+	GracePeriod metav1.Duration `json:"gracePeriod"`
+}
+
+// RetryPolicy defines how the shoot operations failed with non-retryable Gardener errors are retried with the Gardener retry operation
+type RetryPolicy struct {
+	Enabled bool `json:"enabled"`
+	// MaxAttempts is the number of automatic retries of a Runtime, the counter is reset with the retry annotation
+	MaxAttempts int `json:"maxAttempts" validate:"gte=0"`
+	// InitialDelay between the failure and the first retry, one minute is used when not set
+	InitialDelay metav1.Duration `json:"initialDelay"`
+	Factor       float64         `json:"factor" validate:"omitempty,gte=1"`
+	MaxDelay     metav1.Duration `json:"maxDelay"`
+	// ErrorCodes of the Gardener errors which are retried, all errors are retried when not set
+	ErrorCodes []gardener.ErrorCode `json:"errorCodes,omitempty"`
+}
+
+// DriftDetection defines how often the shoots of Ready runtimes are compared with the result of the Runtime conversion
+type DriftDetection struct {
+	Enabled bool `json:"enabled"`
+	// Interval between the checks of a single Runtime, one hour is used when not set
+	Interval metav1.Duration `json:"interval"`
+	// Jitter is the maximal fraction of the interval added randomly to spread the checks of many runtimes
+	Jitter float64 `json:"jitter" validate:"gte=0,lte=1"`
+	// ChecksPerMinute limits the number of checks of all runtimes, the checks are not limited when not set
+	ChecksPerMinute int `json:"checksPerMinute" validate:"gte=0"`
+	// Remediate patches the shoot when a drift is detected
+	Remediate bool `json:"remediate"`
+}
+
+// TimeoutsConfig defines how long the shoot operations may take before the Runtime is flagged with the ProvisioningTimeout condition.
+// Zero durations disable the detection for the operation.
+type TimeoutsConfig struct {
+	ShootCreate metav1.Duration `json:"shootCreate"`
+	ShootUpdate metav1.Duration `json:"shootUpdate"`
+	ShootDelete metav1.Duration `json:"shootDelete"`
+}
+
+// BackoffConfig defines how the Runtime Controller requeue delays grow when a state is requeued repeatedly.
+// The fixed requeue durations are used for the states without any policy.
+type BackoffConfig struct {
+	// Default applies to all states without their own policy
+	Default *BackoffPolicy `json:"default,omitempty"`
+	// States contains policies for the state functions, e.g. "sFnWaitForShootCreation"
+	States map[string]BackoffPolicy `json:"states,omitempty" validate:"omitempty,dive"`
+}
+
+type BackoffPolicy struct {
+	// InitialDelay is the delay of the first requeue, the fixed requeue duration of the state is used when not set
+	InitialDelay metav1.Duration `json:"initialDelay"`
+	Factor       float64         `json:"factor" validate:"gte=1"`
+	MaxDelay     metav1.Duration `json:"maxDelay"`
+	// Jitter is the maximal fraction of the delay added randomly to spread the requeues of many runtimes
+	Jitter float64 `json:"jitter" validate:"gte=0,lte=1"`
+}
+
+// PolicyFor returns the backoff policy for the state function
+func (c BackoffConfig) PolicyFor(stateFnName string) (BackoffPolicy, bool) {
+	if policy, found := c.States[stateFnName]; found {
+		return policy, true
+	}
+
+	if c.Default != nil {
+		return *c.Default, true
+	}
+
+	return BackoffPolicy{}, false
 }
 
 type ClusterConfig struct {
@@ -76,6 +76,7 @@ type PatchOpts struct {
 	Log                   *logr.Logger
 	StructuredAuthEnabled bool
 	RegistryCache         []registrycache.RegistryCache
+	Hibernation           *gardener.Hibernation
 }
 
 func NewConverterCreate(opts CreateOpts) Converter {
@@ -88,6 +89,7 @@ func NewConverterCreate(opts CreateOpts) Converter {
 			opts.MachineImage.DefaultVersion,
 		),
 		extender2.ExtendWithTolerations,
+		extender2.ExtendWithHibernation,
 	)
 
 	if !opts.DNS.IsGardenerInternal() {
@@ -123,7 +125,8 @@ func NewConverterPatch(opts PatchOpts) Converter {
 
 	extendersForPatch = append(extendersForPatch,
 		extensions.NewExtensionsExtenderForPatch(opts.AuditLogData, opts.RegistryCache, opts.Extensions),
-		extender2.NewResourcesExtenderForPatch(opts.Resources))
+		extender2.NewResourcesExtenderForPatch(opts.Resources),
+		extender2.NewHibernationExtenderForPatch(opts.Hibernation))
 
 	extendersForPatch = append(extendersForPatch, extender2.NewKubernetesExtender(opts.Kubernetes.DefaultVersion, opts.ShootK8SVersion))
 
@@ -0,0 +1,112 @@
+package diff
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Change describes a single field of the shoot which differs from the desired value
+type Change struct {
+	Path    string
+	Desired interface{}
+	Actual  interface{}
+}
+
+func (c Change) String() string {
+	return fmt.Sprintf("%s: %v -> %v", c.Path, c.Actual, c.Desired)
+}
+
+// ShootSpec compares the fields set in the desired shoot spec with the actual shoot spec.
+// Fields which are not set in the desired spec (e.g. defaulted by Gardener) are not compared.
+func ShootSpec(desired, actual gardener.Shoot) ([]Change, error) {
+	desiredSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&desired.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	actualSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&actual.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	compare("spec", desiredSpec, actualSpec, false, &changes)
+	return changes, nil
+}
+
+// ShootSpecChanges compares all fields of the shoot spec before and after the change.
+// Unlike ShootSpec, the fields removed by the change are reported as well.
+func ShootSpecChanges(before, after gardener.Shoot) ([]Change, error) {
+	beforeSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&before.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	afterSpec, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&after.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	compare("spec", afterSpec, beforeSpec, true, &changes)
+	return changes, nil
+}
+
+// Paths returns the paths of the changes
+func Paths(changes []Change) []string {
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	return paths
+}
+
+// compare walks the fields of the desired value, the fields set only in the actual value are walked as well when the comparison is symmetric
+func compare(path string, desired, actual interface{}, symmetric bool, changes *[]Change) {
+	switch desiredValue := desired.(type) {
+	case map[string]interface{}:
+		actualMap, ok := actual.(map[string]interface{})
+		if !ok {
+			*changes = append(*changes, Change{Path: path, Desired: desired, Actual: actual})
+			return
+		}
+
+		keys := make([]string, 0, len(desiredValue))
+		for key := range desiredValue {
+			keys = append(keys, key)
+		}
+		if symmetric {
+			for key := range actualMap {
+				if _, found := desiredValue[key]; !found {
+					keys = append(keys, key)
+				}
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			compare(path+"."+key, desiredValue[key], actualMap[key], symmetric, changes)
+		}
+
+	case []interface{}:
+		actualSlice, ok := actual.([]interface{})
+		// list elements are not matched by name, the lists have to contain the elements in the same order
+		if !ok || len(actualSlice) != len(desiredValue) {
+			*changes = append(*changes, Change{Path: path, Desired: desired, Actual: actual})
+			return
+		}
+
+		for i := range desiredValue {
+			compare(fmt.Sprintf("%s[%d]", path, i), desiredValue[i], actualSlice[i], symmetric, changes)
+		}
+
+	default:
+		if !reflect.DeepEqual(desired, actual) {
+			*changes = append(*changes, Change{Path: path, Desired: desired, Actual: actual})
+		}
+	}
+}
@@ -0,0 +1,119 @@
+package diff
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestShootSpec(t *testing.T) {
+	t.Run("Should not report fields set only in the actual shoot", func(t *testing.T) {
+		// given
+		desired := fixShoot("m6i.large", 3)
+		actual := fixShoot("m6i.large", 3)
+		actual.Spec.SeedName = ptr.To("aws-eu1")
+		actual.Spec.Provider.Workers[0].CRI = &gardener.CRI{Name: gardener.CRINameContainerD}
+
+		// when
+		changes, err := ShootSpec(desired, actual)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("Should report changed fields", func(t *testing.T) {
+		// given
+		desired := fixShoot("m6i.large", 3)
+		actual := fixShoot("m6i.xlarge", 5)
+
+		// when
+		changes, err := ShootSpec(desired, actual)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"spec.provider.workers[0].machine.type",
+			"spec.provider.workers[0].maximum",
+		}, Paths(changes))
+		assert.Equal(t, "m6i.large", changes[0].Desired)
+		assert.Equal(t, "m6i.xlarge", changes[0].Actual)
+	})
+
+	t.Run("Should report list with different length", func(t *testing.T) {
+		// given
+		desired := fixShoot("m6i.large", 3)
+		actual := fixShoot("m6i.large", 3)
+		actual.Spec.Provider.Workers = append(actual.Spec.Provider.Workers, gardener.Worker{Name: "manual"})
+
+		// when
+		changes, err := ShootSpec(desired, actual)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"spec.provider.workers"}, Paths(changes))
+	})
+}
+
+func TestShootSpecChanges(t *testing.T) {
+	t.Run("Should report fields removed by the change", func(t *testing.T) {
+		// given
+		before := fixShoot("m6i.large", 3)
+		before.Spec.Provider.Workers[0].Labels = map[string]string{"team": "kyma"}
+		before.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
+			OIDCConfig: &gardener.OIDCConfig{ClientID: ptr.To("client-id")},
+		}
+		after := fixShoot("m6i.large", 3)
+
+		// when
+		changes, err := ShootSpecChanges(before, after)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"spec.kubernetes.kubeAPIServer",
+			"spec.provider.workers[0].labels",
+		}, Paths(changes))
+		assert.Nil(t, changes[1].Desired)
+		assert.Equal(t, map[string]interface{}{"team": "kyma"}, changes[1].Actual)
+	})
+
+	t.Run("Should report fields added and changed by the change", func(t *testing.T) {
+		// given
+		before := fixShoot("m6i.large", 3)
+		after := fixShoot("m6i.xlarge", 3)
+		after.Spec.SeedName = ptr.To("aws-eu1")
+
+		// when
+		changes, err := ShootSpecChanges(before, after)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{
+			"spec.provider.workers[0].machine.type",
+			"spec.seedName",
+		}, Paths(changes))
+	})
+}
+
+func fixShoot(machineType string, maximum int32) gardener.Shoot {
+	return gardener.Shoot{
+		Spec: gardener.ShootSpec{
+			Region: "eu-central-1",
+			Provider: gardener.Provider{
+				Type: "aws",
+				Workers: []gardener.Worker{
+					{
+						Name:    "cpu-worker-0",
+						Machine: gardener.Machine{Type: machineType},
+						Minimum: 1,
+						Maximum: maximum,
+					},
+				},
+			},
+		},
+	}
+}
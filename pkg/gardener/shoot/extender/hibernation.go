@@ -0,0 +1,38 @@
+package extender
+
+import (
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"k8s.io/utils/ptr"
+)
+
+// ExtendWithHibernation sets the hibernation only when it is configured in the Runtime
+func ExtendWithHibernation(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+	if runtime.Spec.Shoot.Hibernation != nil {
+		shoot.Spec.Hibernation = runtime.Spec.Shoot.Hibernation.DeepCopy()
+	}
+
+	return nil
+}
+
+// NewHibernationExtenderForPatch wakes up the hibernated shoot once the enabled flag is removed from the Runtime,
+// the flag is not changed while the hibernation schedules of the Runtime control it
+func NewHibernationExtenderForPatch(currentHibernation *gardener.Hibernation) func(imv1.Runtime, *gardener.Shoot) error {
+	return func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
+		hibernation := runtime.Spec.Shoot.Hibernation.DeepCopy()
+		hibernatedBefore := currentHibernation != nil && ptr.Deref(currentHibernation.Enabled, false)
+
+		if hibernatedBefore && (hibernation == nil || (hibernation.Enabled == nil && len(hibernation.Schedules) == 0)) {
+			if hibernation == nil {
+				hibernation = &gardener.Hibernation{}
+			}
+			hibernation.Enabled = ptr.To(false)
+		}
+
+		if hibernation != nil {
+			shoot.Spec.Hibernation = hibernation
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,90 @@
+package extender
+
+import (
+	"testing"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/extender/testutils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/ptr"
+)
+
+func TestExtendWithHibernation(t *testing.T) {
+	t.Run("Should set hibernation schedules from Runtime", func(t *testing.T) {
+		// given
+		hibernation := &gardener.Hibernation{
+			Enabled: ptr.To(false),
+			Schedules: []gardener.HibernationSchedule{
+				{Start: ptr.To("00 20 * * 1-5"), End: ptr.To("00 08 * * 1-5"), Location: ptr.To("Europe/Berlin")},
+			},
+		}
+		runtime := imv1.Runtime{Spec: imv1.RuntimeSpec{Shoot: imv1.RuntimeShoot{Hibernation: hibernation}}}
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithHibernation(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, hibernation, shoot.Spec.Hibernation)
+	})
+
+	t.Run("Should not set hibernation when not configured in Runtime", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+
+		// when
+		err := ExtendWithHibernation(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Hibernation)
+	})
+}
+
+func TestNewHibernationExtenderForPatch(t *testing.T) {
+	t.Run("Should wake up the shoot when hibernation is removed from Runtime", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewHibernationExtenderForPatch(&gardener.Hibernation{Enabled: ptr.To(true)})
+
+		// when
+		err := extender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, &gardener.Hibernation{Enabled: ptr.To(false)}, shoot.Spec.Hibernation)
+	})
+
+	t.Run("Should not change the enabled flag controlled by the schedules", func(t *testing.T) {
+		// given
+		hibernation := &gardener.Hibernation{
+			Schedules: []gardener.HibernationSchedule{{Start: ptr.To("00 20 * * 1-5")}},
+		}
+		runtime := imv1.Runtime{Spec: imv1.RuntimeSpec{Shoot: imv1.RuntimeShoot{Hibernation: hibernation}}}
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewHibernationExtenderForPatch(&gardener.Hibernation{Enabled: ptr.To(true)})
+
+		// when
+		err := extender(runtime, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, hibernation, shoot.Spec.Hibernation)
+	})
+
+	t.Run("Should not set hibernation when the shoot is not hibernated", func(t *testing.T) {
+		// given
+		shoot := testutils.FixEmptyGardenerShoot("test", "dev")
+		extender := NewHibernationExtenderForPatch(nil)
+
+		// when
+		err := extender(imv1.Runtime{}, &shoot)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shoot.Spec.Hibernation)
+	})
+}
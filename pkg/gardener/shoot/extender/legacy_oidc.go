@@ -33,6 +33,13 @@ func NewLegacyOidcExtender(oidcProvider config.OidcProvider) func(runtime imv1.R
 	}
 }
 
+// DefaultOidcConfigIfNotPresent sets the operator OIDC config in the Runtime, when it does not specify its own one.
+func DefaultOidcConfigIfNotPresent(runtime *imv1.Runtime, oidcProvider config.OidcProvider) {
+	if shouldDefaultOidcConfig(runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig) {
+		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.OidcConfig = oidcProvider.ToOIDCConfig()
+	}
+}
+
 func setKubeAPIServerOIDCConfig(shoot *gardener.Shoot, oidcConfig gardener.OIDCConfig) {
 	shoot.Spec.Kubernetes.KubeAPIServer = &gardener.KubeAPIServerConfig{
 		OIDCConfig: &gardener.OIDCConfig{
@@ -12,43 +12,37 @@ const (
 	EndMaintenanceWindowKey   = "end"
 )
 
-func GetMaintenanceWindow(maintenanceWindowConfigPath, region string) (*gardener.MaintenanceTimeWindow, error) {
-	timeWindow, err := getWindowForRegion(maintenanceWindowConfigPath, region)
+// Windows contains the maintenance time windows keyed by region
+type Windows map[string]map[string]string
 
+func GetMaintenanceWindow(maintenanceWindowConfigPath, region string) (*gardener.MaintenanceTimeWindow, error) {
+	windows, err := LoadWindows(maintenanceWindowConfigPath)
 	if err != nil {
 		return nil, errors.Errorf("error during getting maintanence window data: %s", err.Error())
 	}
 
-	if timeWindow == nil {
-		return nil, errors.Errorf("maintenance window is not defined for region: %s", region)
-	}
-
-	return &gardener.MaintenanceTimeWindow{Begin: timeWindow.Begin, End: timeWindow.End}, nil
+	return windows.GetMaintenanceWindow(region)
 }
 
-func getWindowForRegion(maintenanceWindowConfigPath, region string) (*gardener.MaintenanceTimeWindow, error) {
-	windowData, err := getDataFromFile(maintenanceWindowConfigPath, region)
-
+// LoadWindows reads the maintenance time windows from the JSON file
+func LoadWindows(filepath string) (Windows, error) {
+	fileData, err := os.ReadFile(filepath)
 	if err != nil {
-		return nil, err
+		return nil, errors.Errorf("failed to read file: %s", err.Error())
 	}
 
-	if windowData == nil {
-		return nil, nil
+	var windows Windows
+	if err := json.Unmarshal(fileData, &windows); err != nil {
+		return nil, errors.Errorf("failed to decode json: %s", err.Error())
 	}
-
-	return &gardener.MaintenanceTimeWindow{Begin: windowData[BeginMaintenanceWindowKey], End: windowData[EndMaintenanceWindowKey]}, nil
+	return windows, nil
 }
 
-func getDataFromFile(filepath, region string) (map[string]string, error) {
-	fileData, err := os.ReadFile(filepath)
-	if err != nil {
-		return nil, errors.Errorf("failed to read file: %s", err.Error())
+func (w Windows) GetMaintenanceWindow(region string) (*gardener.MaintenanceTimeWindow, error) {
+	windowData := w[region]
+	if windowData == nil {
+		return nil, errors.Errorf("maintenance window is not defined for region: %s", region)
 	}
 
-	var maintenanceWindow map[string]map[string]string
-	if err := json.Unmarshal(fileData, &maintenanceWindow); err != nil {
-		return nil, errors.Errorf("failed to decode json: %s", err.Error())
-	}
-	return maintenanceWindow[region], nil
+	return &gardener.MaintenanceTimeWindow{Begin: windowData[BeginMaintenanceWindowKey], End: windowData[EndMaintenanceWindowKey]}, nil
 }
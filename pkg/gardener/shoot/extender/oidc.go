@@ -4,6 +4,7 @@ import (
 	"fmt"
 	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/config"
 )
 
 const (
@@ -25,3 +26,30 @@ func NewOidcExtender() func(runtime imv1.Runtime, shoot *gardener.Shoot) error {
 		return nil
 	}
 }
+
+// DefaultAdditionalOidcIfNotPresent sets the shared IAS tenant as the only additional OIDC config,
+// when the Runtime does not specify any complete additional OIDC config.
+func DefaultAdditionalOidcIfNotPresent(runtime *imv1.Runtime, sharedIASTenant config.OidcProvider) {
+	additionalOidcConfig := runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig
+
+	additionalOIDCConfigEmpty := func() bool {
+		if additionalOidcConfig == nil {
+			return true
+		}
+
+		for _, oidcConfig := range *additionalOidcConfig {
+			if oidcConfig.ClientID != nil && oidcConfig.IssuerURL != nil {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	if additionalOIDCConfigEmpty() {
+		additionalOidcConfig = &[]imv1.OIDCConfig{}
+		defaultOIDCConfig := sharedIASTenant.ToOIDCConfig()
+		*additionalOidcConfig = append(*additionalOidcConfig, imv1.OIDCConfig{OIDCConfig: defaultOIDCConfig})
+		runtime.Spec.Shoot.Kubernetes.KubeAPIServer.AdditionalOidcConfig = additionalOidcConfig
+	}
+}
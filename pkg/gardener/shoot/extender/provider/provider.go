@@ -23,9 +23,8 @@ func NewProviderExtenderForCreateOperation(enableIMDSv2 bool, defMachineImgName,
 		provider.Type = rt.Spec.Shoot.Provider.Type
 		provider.Workers = rt.Spec.Shoot.Provider.Workers
 
-		//NOTE: we can have this code moved to validation webhook later on
-		if len(rt.Spec.Shoot.Provider.Workers) != 1 {
-			return errors.New("single main worker is required")
+		if err := ValidateMainWorker(rt.Spec.Shoot.Provider.Workers); err != nil {
+			return err
 		}
 
 		if rt.Spec.Shoot.Provider.AdditionalWorkers != nil {
@@ -63,8 +62,8 @@ func NewProviderExtenderPatchOperation(enableIMDSv2 bool, defMachineImgName, def
 			return errors.New("existing infrastructure config is required")
 		}
 
-		if len(rt.Spec.Shoot.Provider.Workers) != 1 {
-			return errors.New("single main worker is required on the Runtime CR")
+		if err := ValidateMainWorker(rt.Spec.Shoot.Provider.Workers); err != nil {
+			return err
 		}
 
 		if len(shootWorkers) == 0 {
@@ -268,8 +267,13 @@ func setWorkerSettings(provider *gardener.Provider) {
 // It sets the machine image name and version to the values specified in the Runtime worker configuration.
 // If any value is not specified in the Runtime, it sets it as `machineImage.defaultVersion` or `machineImage.defaultName`, set in `converter_config.json`.
 func setMachineImage(provider *gardener.Provider, defMachineImgName, defMachineImgVer string) {
-	for i := 0; i < len(provider.Workers); i++ {
-		worker := &provider.Workers[i]
+	DefaultWorkersMachineImage(provider.Workers, defMachineImgName, defMachineImgVer)
+}
+
+// DefaultWorkersMachineImage sets the machine image name and version for the workers which do not specify them.
+func DefaultWorkersMachineImage(workers []gardener.Worker, defMachineImgName, defMachineImgVer string) {
+	for i := 0; i < len(workers); i++ {
+		worker := &workers[i]
 
 		if worker.Machine.Image == nil {
 			worker.Machine.Image = &gardener.ShootMachineImage{
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+
+	gardener "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	imv1 "github.com/kyma-project/infrastructure-manager/api/v1"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/aws"
+	"github.com/kyma-project/infrastructure-manager/pkg/gardener/shoot/hyperscaler/azure"
+	"github.com/pkg/errors"
+)
+
+// ValidateMainWorker checks that exactly one main worker pool is defined in the Runtime CR.
+func ValidateMainWorker(workers []gardener.Worker) error {
+	if len(workers) != 1 {
+		return errors.New("single main worker is required")
+	}
+	return nil
+}
+
+// ValidateProviderType checks that the provider type is one of the supported hyperscalers.
+func ValidateProviderType(providerType string) error {
+	if !hyperscaler.IsSupported(providerType) {
+		return fmt.Errorf("provider %q not supported", providerType)
+	}
+	return nil
+}
+
+// ValidateNetworkingZones checks that the zones of all worker pools and the nodes CIDR can be used to generate the infrastructure config.
+// The same checks are performed by the converter when the shoot is created or patched.
+func ValidateNetworkingZones(runtimeShoot imv1.RuntimeShoot) error {
+	workers := runtimeShoot.Provider.Workers
+	if runtimeShoot.Provider.AdditionalWorkers != nil {
+		workers = append(workers[:len(workers):len(workers)], *runtimeShoot.Provider.AdditionalWorkers...)
+	}
+
+	zones := getNetworkingZonesFromWorkers(workers)
+
+	switch runtimeShoot.Provider.Type {
+	case hyperscaler.TypeAWS:
+		return aws.ValidateZones(runtimeShoot.Networking.Nodes, zones)
+	case hyperscaler.TypeAzure:
+		return azure.ValidateZones(runtimeShoot.Networking.Nodes, zones)
+	default:
+		return nil
+	}
+}
@@ -43,25 +43,17 @@ The last 5 subnets are using the smaller subnet size (1024 hosts).
 */
 
 func generateAWSZones(workerCidr string, zoneNames []string) ([]v1alpha1.Zone, error) {
-	numZones := len(zoneNames)
-	if numZones < minNumberOfZones || numZones > maxNumberOfZones {
-		return nil, errors.New("Number of networking zones must be between 1 and 8")
-	}
-
 	var zones []v1alpha1.Zone
 
-	cidr, err := netip.ParsePrefix(workerCidr)
-	if err != nil {
-		return zones, errors.Wrap(err, "failed to parse worker network CIDR")
+	if err := ValidateZones(workerCidr, zoneNames); err != nil {
+		return zones, err
 	}
 
+	cidr := netip.MustParsePrefix(workerCidr)
+
 	// CIDR prefix length ex from "10.250.0.0/16" is 16
 	prefixLength := cidr.Bits()
 
-	if prefixLength > maxPrefixSize || prefixLength < minPrefixSize {
-		return nil, errors.New("CIDR prefix length must be between 16 and 24")
-	}
-
 	kymaWorkerNetworkPrefixLength := prefixLength + subNetworkBitsSize
 	workerPrefix, err := cidr.Addr().Prefix(kymaWorkerNetworkPrefixLength)
 	if err != nil {
@@ -92,14 +84,7 @@ func generateAWSZones(workerCidr string, zoneNames []string) ([]v1alpha1.Zone, e
 	additionalWorkerNetworkDelta := big.NewInt(1)
 	additionalWorkerNetworkDelta.Rsh(kymaWorkerNetworkDelta, 2)
 
-	processed := make(map[string]bool)
-
 	for i, name := range zoneNames {
-		if _, ok := processed[name]; ok {
-			return nil, errors.Errorf("zone name %s is duplicated", name)
-		}
-		processed[name] = true
-
 		var workPrefixLength, publicPrefixLength, internalPrefixLength int
 		var deltaStep *big.Int
 
@@ -153,6 +138,36 @@ func generateAWSZones(workerCidr string, zoneNames []string) ([]v1alpha1.Zone, e
 	return zones, nil
 }
 
+// ValidateZones checks if the worker network CIDR and the networking zones can be used to generate the AWS infrastructure config.
+func ValidateZones(workerCidr string, zoneNames []string) error {
+	numZones := len(zoneNames)
+	if numZones < minNumberOfZones || numZones > maxNumberOfZones {
+		return errors.New("Number of networking zones must be between 1 and 8")
+	}
+
+	cidr, err := netip.ParsePrefix(workerCidr)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse worker network CIDR")
+	}
+
+	prefixLength := cidr.Bits()
+
+	if prefixLength > maxPrefixSize || prefixLength < minPrefixSize {
+		return errors.New("CIDR prefix length must be between 16 and 24")
+	}
+
+	processed := make(map[string]bool)
+
+	for _, name := range zoneNames {
+		if _, ok := processed[name]; ok {
+			return errors.Errorf("zone name %s is duplicated", name)
+		}
+		processed[name] = true
+	}
+
+	return nil
+}
+
 func getCIDRFromInt(base *big.Int, prefixLength int, mainCIDR netip.Prefix) (netip.Prefix, error) {
 	addr, _ := netip.AddrFromSlice(base.Bytes())
 	resultCIDR := netip.PrefixFrom(addr, prefixLength)
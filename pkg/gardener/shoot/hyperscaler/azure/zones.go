@@ -16,29 +16,15 @@ const (
 )
 
 func generateAzureZones(workerCidr string, zoneNames []string) ([]Zone, error) {
-	numZones := len(zoneNames)
-	// old Azure lite clusters have no zones in InfrastructureConfig
-	if numZones > maxNumberOfZones {
-		return nil, errors.New("Number of networking zones must be between 0 and 8")
-	}
-
 	var zones []Zone
 
-	cidr, err := netip.ParsePrefix(workerCidr)
-	if err != nil {
-		return zones, errors.Wrap(err, "failed to parse worker network CIDR")
+	if err := ValidateZones(workerCidr, zoneNames); err != nil {
+		return zones, err
 	}
 
+	cidr := netip.MustParsePrefix(workerCidr)
 	prefixLength := cidr.Bits()
 
-	if prefixLength > 24 {
-		return nil, errors.New("CIDR prefix length must be less than or equal to 24")
-	}
-
-	if prefixLength < 16 {
-		return nil, errors.New("CIDR prefix length must be bigger than or equal to 16")
-	}
-
 	workerNetworkPrefixLength := prefixLength + subNetworkBitsSize
 	workerPrefix, _ := cidr.Addr().Prefix(workerNetworkPrefixLength)
 	// delta - it is the difference between CIDRs of two zones:
@@ -50,19 +36,12 @@ func generateAzureZones(workerCidr string, zoneNames []string) ([]Zone, error) {
 	// zoneIPValue - it is an integer, which is based on IP bytes
 	zoneIPValue := new(big.Int).SetBytes(workerPrefix.Addr().AsSlice())
 
-	processed := make(map[int]bool)
-
 	convertedZones, err := convertZoneNames(zoneNames)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to convert zone names")
 	}
 
 	for _, name := range convertedZones {
-		if _, ok := processed[name]; ok {
-			return nil, errors.Errorf("zone name %d is duplicated", name)
-		}
-		processed[name] = true
-
 		zoneWorkerIP, _ := netip.AddrFromSlice(zoneIPValue.Bytes())
 		zoneWorkerCidr := netip.PrefixFrom(zoneWorkerIP, workerNetworkPrefixLength)
 
@@ -86,6 +65,46 @@ func generateAzureZones(workerCidr string, zoneNames []string) ([]Zone, error) {
 	return zones, nil
 }
 
+// ValidateZones checks if the worker network CIDR and the networking zones can be used to generate the Azure infrastructure config.
+func ValidateZones(workerCidr string, zoneNames []string) error {
+	numZones := len(zoneNames)
+	// old Azure lite clusters have no zones in InfrastructureConfig
+	if numZones > maxNumberOfZones {
+		return errors.New("Number of networking zones must be between 0 and 8")
+	}
+
+	cidr, err := netip.ParsePrefix(workerCidr)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse worker network CIDR")
+	}
+
+	prefixLength := cidr.Bits()
+
+	if prefixLength > 24 {
+		return errors.New("CIDR prefix length must be less than or equal to 24")
+	}
+
+	if prefixLength < 16 {
+		return errors.New("CIDR prefix length must be bigger than or equal to 16")
+	}
+
+	convertedZones, err := convertZoneNames(zoneNames)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert zone names")
+	}
+
+	processed := make(map[int]bool)
+
+	for _, name := range convertedZones {
+		if _, ok := processed[name]; ok {
+			return errors.Errorf("zone name %d is duplicated", name)
+		}
+		processed[name] = true
+	}
+
+	return nil
+}
+
 func convertZoneNames(zoneNames []string) ([]int, error) {
 	var zones []int
 	for _, inputZone := range zoneNames {
@@ -6,3 +6,13 @@ const (
 	TypeGCP       = "gcp"
 	TypeOpenStack = "openstack"
 )
+
+// IsSupported returns true if the provider type is one of the hyperscalers handled by the converter
+func IsSupported(providerType string) bool {
+	switch providerType {
+	case TypeAWS, TypeAzure, TypeGCP, TypeOpenStack:
+		return true
+	default:
+		return false
+	}
+}
@@ -3,6 +3,20 @@ package reconciler
 const (
 	ForceReconcileAnnotation   = "operator.kyma-project.io/force-patch-reconciliation"
 	SuspendReconcileAnnotation = "operator.kyma-project.io/suspend-patch-reconciliation"
+	RetryAnnotation            = "operator.kyma-project.io/retry"
+	DeletionPolicyAnnotation   = "operator.kyma-project.io/deletion-policy"
+	AdoptAnnotation            = "operator.kyma-project.io/adopt"
+	AdoptAcknowledgeAnnotation = "operator.kyma-project.io/adopt-acknowledged"
+	DryRunAnnotation           = "operator.kyma-project.io/dry-run"
+
+	DeletionProtectionAnnotation       = "operator.kyma-project.io/deletion-protection"
+	DeletionProtectionUnlockAnnotation = "operator.kyma-project.io/deletion-protection-unlock"
+	DeletionRequestedAnnotation        = "operator.kyma-project.io/deletion-requested"
+	RestoreAnnotation                  = "operator.kyma-project.io/restore"
+	RotateCredentialsAnnotation        = "operator.kyma-project.io/rotate-credentials"
+
+	// DeletionPolicyOrphan keeps the shoot running when the Runtime is deleted
+	DeletionPolicyOrphan = "Orphan"
 )
 
 func ShouldSuspendReconciliation(annotations map[string]string) bool {
@@ -20,3 +34,44 @@ func ShouldForceReconciliation(annotations map[string]string) bool {
 	}
 	return false
 }
+
+func ShouldRetry(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, RetryAnnotation, "true")
+}
+
+func ShouldOrphanShoot(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, DeletionPolicyAnnotation, DeletionPolicyOrphan)
+}
+
+func ShouldAdoptShoot(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, AdoptAnnotation, "true")
+}
+
+func ShouldDryRun(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, DryRunAnnotation, "true")
+}
+
+func ShouldProtectDeletion(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, DeletionProtectionAnnotation, "true")
+}
+
+func IsDeletionProtectionUnlocked(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, DeletionProtectionUnlockAnnotation, "true")
+}
+
+func IsDeletionRequested(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, DeletionRequestedAnnotation, "true")
+}
+
+func ShouldRestore(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, RestoreAnnotation, "true")
+}
+
+func ShouldRotateCredentials(annotations map[string]string) bool {
+	return hasAnnotationValue(annotations, RotateCredentialsAnnotation, "true")
+}
+
+func hasAnnotationValue(annotations map[string]string, key, value string) bool {
+	actual, found := annotations[key]
+	return found && actual == value
+}
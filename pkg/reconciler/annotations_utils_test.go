@@ -72,3 +72,111 @@ func TestShouldSuspendReconciliation(t *testing.T) {
 		})
 	}
 }
+
+func TestAnnotationPredicates(t *testing.T) {
+	for _, testCase := range []struct {
+		name           string
+		predicate      func(map[string]string) bool
+		annotations    map[string]string
+		expectedResult bool
+	}{
+		{
+			name:           "Should retry for `operator.kyma-project.io/retry` set to `true`",
+			predicate:      ShouldRetry,
+			annotations:    map[string]string{RetryAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not retry for `operator.kyma-project.io/retry` set to `false`",
+			predicate:      ShouldRetry,
+			annotations:    map[string]string{RetryAnnotation: "false"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should not retry for nil annotations",
+			predicate:      ShouldRetry,
+			annotations:    nil,
+			expectedResult: false,
+		},
+		{
+			name:           "Should orphan shoot for `operator.kyma-project.io/deletion-policy` set to `Orphan`",
+			predicate:      ShouldOrphanShoot,
+			annotations:    map[string]string{DeletionPolicyAnnotation: DeletionPolicyOrphan},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not orphan shoot for `operator.kyma-project.io/deletion-policy` set to `true`",
+			predicate:      ShouldOrphanShoot,
+			annotations:    map[string]string{DeletionPolicyAnnotation: "true"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should adopt shoot for `operator.kyma-project.io/adopt` set to `true`",
+			predicate:      ShouldAdoptShoot,
+			annotations:    map[string]string{AdoptAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should dry run for `operator.kyma-project.io/dry-run` set to `true`",
+			predicate:      ShouldDryRun,
+			annotations:    map[string]string{DryRunAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should protect deletion for `operator.kyma-project.io/deletion-protection` set to `true`",
+			predicate:      ShouldProtectDeletion,
+			annotations:    map[string]string{DeletionProtectionAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not protect deletion for nil annotations",
+			predicate:      ShouldProtectDeletion,
+			annotations:    nil,
+			expectedResult: false,
+		},
+		{
+			name:           "Should unlock deletion protection for `operator.kyma-project.io/deletion-protection-unlock` set to `true`",
+			predicate:      IsDeletionProtectionUnlocked,
+			annotations:    map[string]string{DeletionProtectionUnlockAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should request deletion for `operator.kyma-project.io/deletion-requested` set to `true`",
+			predicate:      IsDeletionRequested,
+			annotations:    map[string]string{DeletionRequestedAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should restore for `operator.kyma-project.io/restore` set to `true`",
+			predicate:      ShouldRestore,
+			annotations:    map[string]string{RestoreAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not restore for the annotation of another predicate",
+			predicate:      ShouldRestore,
+			annotations:    map[string]string{RetryAnnotation: "true"},
+			expectedResult: false,
+		},
+		{
+			name:           "Should rotate credentials for `operator.kyma-project.io/rotate-credentials` set to `true`",
+			predicate:      ShouldRotateCredentials,
+			annotations:    map[string]string{RotateCredentialsAnnotation: "true"},
+			expectedResult: true,
+		},
+		{
+			name:           "Should not rotate credentials for `operator.kyma-project.io/rotate-credentials` set to `kaloryfer`",
+			predicate:      ShouldRotateCredentials,
+			annotations:    map[string]string{RotateCredentialsAnnotation: "kaloryfer"},
+			expectedResult: false,
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			// when
+			result := testCase.predicate(testCase.annotations)
+
+			// then
+			assert.Equal(t, testCase.expectedResult, result)
+		})
+	}
+}